@@ -55,6 +55,21 @@ func (g *Greeter) Execute(ctx context.Context, cmd api.GreetCommand) api.Result[
 	return g.useCase.Execute(ctx, cmd)
 }
 
+// SnapshotWriter accumulates greeting output in memory for snapshot testing.
+// Re-exported so consumers can snapshot-test without importing infrastructure.
+type SnapshotWriter = adapter.SnapshotWriter
+
+// NewSnapshotWriter creates an empty SnapshotWriter. Pair it with
+// GreeterWithWriter to capture greeting output:
+//
+//	snap := desktop.NewSnapshotWriter()
+//	greeter := desktop.GreeterWithWriter[*desktop.SnapshotWriter](snap)
+//	// ... execute greetings ...
+//	output := snap.Snapshot()
+func NewSnapshotWriter() *SnapshotWriter {
+	return adapter.NewSnapshotWriter()
+}
+
 // GreeterWithWriter creates a Greeter with a custom writer.
 // Use this when you need to redirect output (e.g., to a buffer for testing).
 func GreeterWithWriter[W api.WriterPort](writer W) *GreeterCustom[W] {
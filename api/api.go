@@ -61,6 +61,7 @@ type Person = valueobject.Person
 const (
 	ValidationError     = domerr.ValidationError
 	InfrastructureError = domerr.InfrastructureError
+	NotFoundError       = domerr.NotFoundError
 )
 
 // Ok creates a successful Result containing the given value.
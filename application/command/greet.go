@@ -22,6 +22,8 @@
 //	result := greetUseCase.Execute(cmd)
 package command
 
+import "github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+
 // GreetCommand is a Data Transfer Object for the greet use case.
 //
 // This DTO crosses the API/outer layer -> application boundary. It may carry
@@ -34,6 +36,15 @@ package command
 //   - Separates external API from internal domain model
 type GreetCommand struct {
 	Name string
+
+	// Language selects the greeting locale (see valueobject.Language).
+	// The zero value means English, so existing constructors and literals
+	// keep their behavior.
+	Language valueobject.Language
+
+	// Uppercase requests the greeting be upper-cased before it is written
+	// (e.g. "HELLO, ALICE!"), for signage-style output.
+	Uppercase bool
 }
 
 // NewGreetCommand creates a new GreetCommand DTO from a name string.
@@ -44,7 +55,25 @@ func NewGreetCommand(name string) GreetCommand {
 	return GreetCommand{Name: name}
 }
 
+// NewGreetCommandInLanguage creates a GreetCommand requesting the greeting
+// in the given language. Like NewGreetCommand, no validation happens here;
+// an unknown language falls back to English in the domain.
+func NewGreetCommandInLanguage(name string, lang valueobject.Language) GreetCommand {
+	return GreetCommand{Name: name, Language: lang}
+}
+
 // GetName extracts the name as a string.
 func (c GreetCommand) GetName() string {
 	return c.Name
 }
+
+// GetLanguage extracts the requested greeting language; the zero value
+// means English.
+func (c GreetCommand) GetLanguage() valueobject.Language {
+	return c.Language
+}
+
+// GetUppercase reports whether the greeting should be upper-cased.
+func (c GreetCommand) GetUppercase() bool {
+	return c.Uppercase
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: error
+// Description: Shared ErrorKind-to-response mappings for presentation adapters
+
+package error
+
+// Exit codes shared by process-oriented presentation adapters.
+//
+// Contract:
+//   - ExitCodeSuccess (0): operation succeeded
+//   - ExitCodeValidation (1): the caller's input was at fault
+//   - ExitCodeInfrastructure (2): the system failed the caller
+const (
+	ExitCodeSuccess        = 0
+	ExitCodeValidation     = 1
+	ExitCodeInfrastructure = 2
+)
+
+// ExitCodeFor maps an error to the process exit code every CLI-style
+// adapter should return for it. Centralizing the mapping here means a new
+// ErrorKind updates every adapter at once instead of drifting per adapter.
+//
+// Caller-fault kinds (validation, not-found) map to ExitCodeValidation;
+// everything else - including kinds added later - is treated as an
+// infrastructure failure.
+func ExitCodeFor(err ErrorType) int {
+	switch err.Kind {
+	case ValidationError, NotFoundError:
+		return ExitCodeValidation
+	default:
+		return ExitCodeInfrastructure
+	}
+}
+
+// StatusFor maps an error to the HTTP status code an HTTP/RPC-style adapter
+// should respond with: 400 for validation failures, 404 for missed lookups,
+// and 500 for infrastructure failures and any kind added later.
+func StatusFor(err ErrorType) int {
+	switch err.Kind {
+	case ValidationError:
+		return 400
+	case NotFoundError:
+		return 404
+	default:
+		return 500
+	}
+}
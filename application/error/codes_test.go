@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationErrorCodes tests the shared ErrorKind response mappings.
+func TestApplicationErrorCodes(t *testing.T) {
+	tf := test.New("Application.Error.Codes")
+
+	// ========================================================================
+	// Test: ExitCodeFor covers every kind plus the future-kind default
+	// ========================================================================
+
+	tf.RunTest("ExitCodeFor - validation is caller fault",
+		ExitCodeFor(NewValidationError("bad")) == ExitCodeValidation)
+	tf.RunTest("ExitCodeFor - not-found is caller fault",
+		ExitCodeFor(NewNotFoundError("missing")) == ExitCodeValidation)
+	tf.RunTest("ExitCodeFor - infrastructure is system fault",
+		ExitCodeFor(NewInfrastructureError("down")) == ExitCodeInfrastructure)
+	tf.RunTest("ExitCodeFor - unknown kind defaults to infrastructure",
+		ExitCodeFor(ErrorType{Kind: ErrorKind(99), Message: "future"}) == ExitCodeInfrastructure)
+
+	// ========================================================================
+	// Test: StatusFor covers every kind plus the future-kind default
+	// ========================================================================
+
+	tf.RunTest("StatusFor - validation maps to 400",
+		StatusFor(NewValidationError("bad")) == 400)
+	tf.RunTest("StatusFor - not-found maps to 404",
+		StatusFor(NewNotFoundError("missing")) == 404)
+	tf.RunTest("StatusFor - infrastructure maps to 500",
+		StatusFor(NewInfrastructureError("down")) == 500)
+	tf.RunTest("StatusFor - unknown kind defaults to 500",
+		StatusFor(ErrorType{Kind: ErrorKind(99), Message: "future"}) == 500)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
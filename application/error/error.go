@@ -44,6 +44,8 @@ type ErrorKind = domerr.ErrorKind
 const (
 	ValidationError     = domerr.ValidationError
 	InfrastructureError = domerr.InfrastructureError
+	NotFoundError       = domerr.NotFoundError
+	TimeoutError        = domerr.TimeoutError
 )
 
 // ErrorType is the concrete error type (re-exported from domain)
@@ -58,4 +60,6 @@ type Result[T any] = domerr.Result[T]
 var (
 	NewValidationError     = domerr.NewValidationError
 	NewInfrastructureError = domerr.NewInfrastructureError
+	NewNotFoundError       = domerr.NewNotFoundError
+	NewTimeoutError        = domerr.NewTimeoutError
 )
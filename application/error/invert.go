@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: error
+// Description: Polarity inversion for Unit results
+
+package error
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Invert flips the polarity of a Unit result for negative assertions where
+// the error is the "success" case (e.g. "name must NOT already exist"):
+// Ok becomes Err(ifOkErr), and any Err becomes Ok(Unit), its original error
+// discarded.
+//
+// Only Unit results can be inverted - a value-carrying Ok has nothing to
+// become on the error track, and an Err has no value to produce.
+//
+// Example:
+//
+//	// lookup succeeds when the name exists; we require that it does not
+//	result := Invert(lookup(name), NewValidationError("name already taken"))
+func Invert(r Result[model.Unit], ifOkErr ErrorType) Result[model.Unit] {
+	if r.IsOk() {
+		return domerr.Err[model.Unit](ifOkErr)
+	}
+	return domerr.Ok(model.UnitValue)
+}
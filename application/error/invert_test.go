@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationErrorInvert tests polarity inversion for Unit results.
+func TestApplicationErrorInvert(t *testing.T) {
+	tf := test.New("Application.Error.Invert")
+
+	taken := NewValidationError("name already taken")
+
+	// ========================================================================
+	// Test: Ok becomes the supplied error
+	// ========================================================================
+
+	r1 := Invert(domerr.Ok(model.UnitValue), taken)
+	tf.RunTest("Ok - inverted to Err", r1.IsError())
+	tf.RunTest("Ok - supplied error used",
+		r1.IsError() && r1.ErrorInfo() == taken)
+
+	// ========================================================================
+	// Test: Err becomes Ok, its original error discarded
+	// ========================================================================
+
+	r2 := Invert(domerr.Err[model.Unit](NewNotFoundError("no such name")), taken)
+	tf.RunTest("Err - inverted to Ok", r2.IsOk())
+
+	// ========================================================================
+	// Test: Double inversion restores the original polarity
+	// ========================================================================
+
+	r3 := Invert(Invert(domerr.Ok(model.UnitValue), taken), taken)
+	tf.RunTest("Double inversion - Ok again", r3.IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: health
+// Description: Aggregated health checking across output adapters
+
+// Package health provides application-level health aggregation across the
+// writers a service is wired with, so one call reports overall health.
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer
+//   - Depends only on application ports and domain types
+//   - Uses the outbound.Healther capability interface; writers without it
+//     are treated as healthy (nothing to check)
+package health
+
+import (
+	"context"
+	"fmt"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// CheckWriters runs the health check of every writer that implements
+// outbound.Healther and aggregates the failures.
+//
+// Returns Ok(Unit) when all checked writers are healthy (writers without a
+// health check are skipped). Otherwise returns an InfrastructureError whose
+// message aggregates every unhealthy writer, identified by its position in
+// the argument list, via a domain MultiError.
+//
+// Example:
+//
+//	result := health.CheckWriters(ctx, consoleWriter, remoteWriter)
+//	if result.IsError() {
+//	    log.Println(result.ErrorInfo().Message)
+//	}
+func CheckWriters(ctx context.Context, ws ...outbound.WriterPort) domerr.Result[model.Unit] {
+	var multi domerr.MultiError
+
+	for i, w := range ws {
+		h, ok := w.(outbound.Healther)
+		if !ok {
+			continue
+		}
+		if r := h.Healthy(ctx); r.IsError() {
+			info := r.ErrorInfo()
+			multi.Append(apperr.NewInfrastructureError(
+				fmt.Sprintf("writer %d unhealthy: %s", i, info.Message)))
+		}
+	}
+
+	if multi.HasErrors() {
+		return domerr.Err[model.Unit](multi.ToErrorType(domerr.InfrastructureError))
+	}
+	return domerr.Ok(model.UnitValue)
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package health
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// healthyWriter is a writer whose health check always passes.
+type healthyWriter struct{}
+
+func (healthyWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.Ok(model.UnitValue)
+}
+
+func (healthyWriter) Healthy(ctx context.Context) domerr.Result[model.Unit] {
+	return domerr.Ok(model.UnitValue)
+}
+
+// unhealthyWriter is a writer whose health check fails with a fixed reason.
+type unhealthyWriter struct {
+	reason string
+}
+
+func (unhealthyWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.Ok(model.UnitValue)
+}
+
+func (uw unhealthyWriter) Healthy(ctx context.Context) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](apperr.NewInfrastructureError(uw.reason))
+}
+
+// plainWriter implements only WriterPort (no health check).
+type plainWriter struct{}
+
+func (plainWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationHealthCheckWriters tests aggregated writer health checking.
+func TestApplicationHealthCheckWriters(t *testing.T) {
+	tf := test.New("Application.Health.CheckWriters")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: All-healthy writers report Ok
+	// ========================================================================
+
+	r1 := CheckWriters(ctx, healthyWriter{}, healthyWriter{})
+	tf.RunTest("All healthy - IsOk returns true", r1.IsOk())
+
+	// ========================================================================
+	// Test: No writers at all is vacuously healthy
+	// ========================================================================
+
+	r2 := CheckWriters(ctx)
+	tf.RunTest("No writers - IsOk returns true", r2.IsOk())
+
+	// ========================================================================
+	// Test: Writers without a health check are treated as healthy
+	// ========================================================================
+
+	r3 := CheckWriters(ctx, plainWriter{}, healthyWriter{})
+	tf.RunTest("No Healther - IsOk returns true", r3.IsOk())
+
+	// ========================================================================
+	// Test: One unhealthy writer fails the aggregate with its position
+	// ========================================================================
+
+	r4 := CheckWriters(ctx, healthyWriter{}, unhealthyWriter{reason: "endpoint unreachable"})
+	tf.RunTest("One unhealthy - IsError returns true", r4.IsError())
+	if r4.IsError() {
+		info := r4.ErrorInfo()
+		tf.RunTest("One unhealthy - kind is infrastructure",
+			info.Kind == domerr.InfrastructureError)
+		tf.RunTest("One unhealthy - message names the writer",
+			strings.Contains(info.Message, "writer 1 unhealthy: endpoint unreachable"))
+	}
+
+	// ========================================================================
+	// Test: Several unhealthy writers are aggregated into one message
+	// ========================================================================
+
+	r5 := CheckWriters(ctx,
+		unhealthyWriter{reason: "disk full"},
+		healthyWriter{},
+		unhealthyWriter{reason: "endpoint unreachable"})
+	tf.RunTest("Several unhealthy - IsError returns true", r5.IsError())
+	if r5.IsError() {
+		msg := r5.ErrorInfo().Message
+		tf.RunTest("Several unhealthy - both failures aggregated",
+			strings.Contains(msg, "writer 0 unhealthy: disk full") &&
+				strings.Contains(msg, "writer 2 unhealthy: endpoint unreachable"))
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
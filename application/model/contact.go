@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Contact model combining validated name and email
+
+package model
+
+import (
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// Contact pairs a validated Person with a validated Email.
+//
+// Contract:
+//   - Both components are valid (enforced by CreateContact)
+//   - Use CreateContact() to instantiate, not struct literal
+type Contact struct {
+	person valueobject.Person
+	email  valueobject.Email
+}
+
+// CreateContact validates name and email together, accumulating every
+// failure instead of short-circuiting on the first: when both inputs are
+// invalid, the returned ValidationError aggregates both messages via
+// MultiError.
+//
+// This is the accumulate-all-errors counterpart to the railway style used
+// elsewhere — appropriate for form-like input where users should see all
+// problems at once.
+//
+// Example:
+//
+//	result := model.CreateContact("Alice", "alice@example.com")
+func CreateContact(name, email string) domerr.Result[Contact] {
+	personResult := valueobject.CreatePerson(name)
+	emailResult := valueobject.CreateEmail(email)
+
+	var multi domerr.MultiError
+	if personResult.IsError() {
+		multi.Append(personResult.ErrorInfo())
+	}
+	if emailResult.IsError() {
+		multi.Append(emailResult.ErrorInfo())
+	}
+	if multi.HasErrors() {
+		return domerr.Err[Contact](multi.ToErrorType(domerr.ValidationError))
+	}
+
+	return domerr.Ok(Contact{
+		person: personResult.Value(),
+		email:  emailResult.Value(),
+	})
+}
+
+// GetPerson returns the validated person component.
+func (c Contact) GetPerson() valueobject.Person {
+	return c.person
+}
+
+// GetEmail returns the validated email component.
+func (c Contact) GetEmail() valueobject.Email {
+	return c.email
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationModelCreateContact tests accumulate-all-errors validation.
+func TestApplicationModelCreateContact(t *testing.T) {
+	tf := test.New("Application.Model.CreateContact")
+
+	// ========================================================================
+	// Test: Both components valid yields a usable Contact
+	// ========================================================================
+
+	r1 := CreateContact("Alice", "alice@example.com")
+	tf.RunTest("Both valid - Ok", r1.IsOk())
+	tf.RunTest("Both valid - components retrievable",
+		r1.IsOk() &&
+			r1.Value().GetPerson().GetName() == "Alice" &&
+			r1.Value().GetEmail().GetAddress() == "alice@example.com")
+
+	// ========================================================================
+	// Test: A single invalid component reports only its own failure
+	// ========================================================================
+
+	r2 := CreateContact("", "alice@example.com")
+	tf.RunTest("Name invalid - error", r2.IsError())
+	tf.RunTest("Name invalid - only the name problem reported",
+		r2.IsError() &&
+			strings.Contains(r2.ErrorInfo().Message, "name") &&
+			!strings.Contains(r2.ErrorInfo().Message, "Email"))
+
+	r3 := CreateContact("Alice", "not-an-email")
+	tf.RunTest("Email invalid - error", r3.IsError())
+	tf.RunTest("Email invalid - only the email problem reported",
+		r3.IsError() && strings.Contains(r3.ErrorInfo().Message, "Email"))
+
+	// ========================================================================
+	// Test: Both invalid aggregates both messages, not just the first
+	// ========================================================================
+
+	r4 := CreateContact("", "not-an-email")
+	tf.RunTest("Both invalid - error with validation kind",
+		r4.IsError() && r4.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Both invalid - both problems aggregated",
+		r4.IsError() &&
+			strings.Contains(r4.ErrorInfo().Message, "name") &&
+			strings.Contains(r4.ErrorInfo().Message, "Email"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
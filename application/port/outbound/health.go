@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Optional health-check capability for output adapters
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Healther is an optional capability interface for output adapters that can
+// report their own health (e.g. a network writer probing its endpoint).
+//
+// Adapters opt in by implementing Healthy alongside WriterPort; callers
+// discover the capability with a type assertion. Writers that do not
+// implement Healther are treated as healthy by aggregators, since no
+// check is available to fail.
+type Healther interface {
+	// Healthy returns Ok(Unit) when the adapter is able to perform its
+	// work, or an InfrastructureError describing why it is not.
+	Healthy(ctx context.Context) domerr.Result[model.Unit]
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// blockingWriter honors the WriterPort cancellation contract: it blocks
+// until ctx is done (or an optional release), never producing output once
+// cancelled. Written messages are recorded only on success.
+type blockingWriter struct {
+	messages []string
+}
+
+func (bw *blockingWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	<-ctx.Done()
+	return domerr.Err[model.Unit](domerr.NewInfrastructureError(
+		"write cancelled: " + ctx.Err().Error()))
+}
+
+// TestApplicationUsecaseGreetCancellation exercises context cancellation
+// and deadlines end-to-end through Execute, validating the WriterPort
+// contract across layers.
+func TestApplicationUsecaseGreetCancellation(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Cancellation")
+
+	// ========================================================================
+	// Test: Context already done before Execute
+	// ========================================================================
+
+	writer1 := &captureWriter{}
+	uc1 := NewGreetUseCase[*captureWriter](writer1)
+
+	doneCtx, cancel1 := context.WithCancel(context.Background())
+	cancel1()
+
+	// captureWriter ignores ctx, so use a context-aware double instead
+	blocking := &blockingWriter{}
+	uc2 := NewGreetUseCase[*blockingWriter](blocking)
+
+	r1 := uc2.Execute(doneCtx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Pre-cancelled context - Execute returns Err", r1.IsError())
+	if r1.IsError() {
+		tf.RunTest("Pre-cancelled context - kind is InfrastructureError",
+			r1.ErrorInfo().Kind == domerr.InfrastructureError)
+	}
+	tf.RunTest("Pre-cancelled context - no partial output",
+		len(blocking.messages) == 0)
+
+	// ========================================================================
+	// Test: Deadline expires while the writer blocks
+	// ========================================================================
+
+	deadlineCtx, cancel2 := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel2()
+
+	start := time.Now()
+	r2 := uc2.Execute(deadlineCtx, command.NewGreetCommand("Bob"))
+	elapsed := time.Since(start)
+
+	tf.RunTest("Expired deadline - Execute returns Err", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("Expired deadline - kind is InfrastructureError",
+			r2.ErrorInfo().Kind == domerr.InfrastructureError)
+		tf.RunTest("Expired deadline - error mentions cancellation",
+			len(r2.ErrorInfo().Message) > 0)
+	}
+	tf.RunTest("Expired deadline - returned promptly (not hung)",
+		elapsed < 5*time.Second)
+	tf.RunTest("Expired deadline - no partial output", len(blocking.messages) == 0)
+
+	// ========================================================================
+	// Test: Validation errors do not reach the writer even when cancelled
+	// ========================================================================
+
+	r3 := uc2.Execute(doneCtx, command.NewGreetCommand(""))
+	tf.RunTest("Invalid name + cancelled ctx - ValidationError wins",
+		r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: Active context still succeeds (control case)
+	// ========================================================================
+
+	r4 := uc1.Execute(context.Background(), command.NewGreetCommand("Carol"))
+	tf.RunTest("Active context - Execute returns Ok", r4.IsOk())
+	tf.RunTest("Active context - output written", len(writer1.messages) == 1)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -45,6 +45,7 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
 	"github.com/abitofhelp/hybrid_lib_go/application/command"
 	"github.com/abitofhelp/hybrid_lib_go/application/model"
@@ -75,9 +76,58 @@ import (
 //   - Generic over port abstraction (static dispatch)
 //   - Returns Result for functional error handling
 //
+// GreetConfig carries optional behavior settings for GreetUseCase.
+//
+// The zero value preserves the default behavior, so existing callers of
+// NewGreetUseCase are unaffected as new options are added.
+//
+// Design Notes:
+//   - Kept free of the writer type parameter so configs can be built and
+//     reused independently of the concrete writer
+//   - Applied at the application layer; the domain (Person) stays pure
+type GreetConfig struct {
+	// Decoration is appended verbatim after the greeting's terminal "!"
+	// (e.g. " 👋"). Empty means no decoration.
+	Decoration string
+
+	// Silent computes and validates the greeting but skips the write,
+	// returning Ok(Unit) once validation succeeds. Use for fire-and-forget
+	// flows where only the validation outcome matters. Distinct from any
+	// CLI-level quiet flag, which only affects stderr rendering.
+	Silent bool
+
+	// AnonymousName is substituted for an empty input name before domain
+	// validation runs (e.g. "stranger" greets "Hello, stranger!"). Empty
+	// means no substitution: the domain stays strict and an empty name is
+	// a ValidationError as before.
+	AnonymousName string
+
+	// Format is a greeting template containing the "{name}" placeholder,
+	// substituted via Person.GreetingMessageFormat (e.g. "Hi {name} :)").
+	// Empty means the classic "Hello, {name}!" greeting. A template
+	// missing the placeholder is emitted verbatim; see
+	// GreetingMessageFormat for that contract.
+	Format string
+
+	// Transform is applied to the finished greeting (after decoration)
+	// just before the write, e.g. strings.ToUpper for signage output.
+	// Nil means identity. A per-command Uppercase request overrides it.
+	Transform TransformFunc
+}
+
+// TransformFunc rewrites the finished greeting before it is written. The
+// domain stays pure: transforms are an application-level concern layered
+// over whatever message the Person produced.
+type TransformFunc func(string) string
+
+// DefaultGreetingFormat is the template the use case applies when
+// GreetConfig.Format is empty, matching Person.GreetingMessage.
+const DefaultGreetingFormat = "Hello, {name}!"
+
 // Implements: inbound.GreetPort interface
 type GreetUseCase[W outbound.WriterPort] struct {
 	writer W
+	config GreetConfig
 }
 
 // NewGreetUseCase creates a new GreetUseCase with injected dependencies.
@@ -96,6 +146,29 @@ func NewGreetUseCase[W outbound.WriterPort](writer W) *GreetUseCase[W] {
 	return &GreetUseCase[W]{writer: writer}
 }
 
+// NewGreetUseCaseWithConfig creates a GreetUseCase with explicit behavior
+// settings. Use this when defaults (no decoration) are not wanted.
+//
+// Example:
+//
+//	uc := usecase.NewGreetUseCaseWithConfig[*adapter.ConsoleWriter](
+//	    writer, usecase.GreetConfig{Decoration: " 👋"})
+func NewGreetUseCaseWithConfig[W outbound.WriterPort](writer W, config GreetConfig) *GreetUseCase[W] {
+	return &GreetUseCase[W]{writer: writer, config: config}
+}
+
+// NewGreetUseCaseWithFormat creates a GreetUseCase greeting with the given
+// "{name}" template instead of the default "Hello, {name}!". Shorthand for
+// NewGreetUseCaseWithConfig with only Format set.
+//
+// Example:
+//
+//	uc := usecase.NewGreetUseCaseWithFormat[*adapter.ConsoleWriter](
+//	    writer, "Hi {name} :)")
+func NewGreetUseCaseWithFormat[W outbound.WriterPort](writer W, format string) *GreetUseCase[W] {
+	return &GreetUseCase[W]{writer: writer, config: GreetConfig{Format: format}}
+}
+
 // Execute runs the greeting use case.
 //
 // Orchestration workflow:
@@ -128,6 +201,12 @@ func (uc *GreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand
 	// Step 1: Extract name from DTO
 	name := cmd.GetName()
 
+	// Step 1b: Opt-in anonymous default - substitute before validation so
+	// the domain itself stays strict about empty names
+	if name == "" && uc.config.AnonymousName != "" {
+		name = uc.config.AnonymousName
+	}
+
 	// Step 2: Validate and create Person from name (domain validation)
 	personResult := valueobject.CreatePerson(name)
 
@@ -141,8 +220,39 @@ func (uc *GreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand
 	// Extract validated Person
 	person := personResult.Value()
 
-	// Step 3: Generate greeting message from Person (pure domain logic)
-	message := person.GreetingMessage()
+	// Step 3: Generate greeting message from Person (pure domain logic).
+	// A configured template routes through GreetingMessageFormat and wins
+	// over any per-command language; otherwise the command's language
+	// picks the localized greeting, with the zero value meaning English
+	// so the classic path is unchanged.
+	var message string
+	if uc.config.Format != "" {
+		message = person.GreetingMessageFormat(uc.config.Format)
+	} else {
+		message = person.GreetingMessageIn(cmd.GetLanguage())
+	}
+
+	// Step 3b: Apply optional decoration (application-level concern, keeping
+	// the Person value object free of presentation details)
+	if uc.config.Decoration != "" {
+		message += uc.config.Decoration
+	}
+
+	// Step 3c: Transform the finished greeting, after decoration so the
+	// whole output line is rewritten. A per-command uppercase request
+	// (the --uppercase flag) overrides any configured transform.
+	transform := uc.config.Transform
+	if cmd.GetUppercase() {
+		transform = strings.ToUpper
+	}
+	if transform != nil {
+		message = transform(message)
+	}
+
+	// Step 3d: Silent mode - validation succeeded, skip the write entirely
+	if uc.config.Silent {
+		return domerr.Ok(model.UnitValue)
+	}
 
 	// Step 4: Write to console via output port (STATIC DISPATCH)
 	// The writer.Write() call is statically dispatched because W is a concrete type
@@ -152,3 +262,24 @@ func (uc *GreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand
 	// Step 5: Propagate result (success or failure) to caller
 	return writeResult
 }
+
+// Validate runs the greeting workflow's validation steps - anonymous-name
+// substitution followed by domain validation - without generating or
+// writing a greeting. Use it for exit-code-only flows (the CLI's --quiet
+// mode) where only the validation outcome matters.
+//
+// Unlike the Silent config option, which is fixed at construction, Validate
+// is a per-call choice on the same use case instance. The ctx parameter is
+// accepted for signature symmetry with Execute; validation itself is pure.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) if the name would greet successfully
+//   - Post: Returns Err(ValidationError) if name validation failed
+func (uc *GreetUseCase[W]) Validate(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	name := cmd.GetName()
+	if name == "" && uc.config.AnonymousName != "" {
+		name = uc.config.AnonymousName
+	}
+	return domerr.MapTo(valueobject.CreatePerson(name),
+		func(valueobject.Person) model.Unit { return model.UnitValue })
+}
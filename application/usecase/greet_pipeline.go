@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Functional one-call greet pipeline
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// Greet composes the full greeting flow as a single function:
+// CreatePerson → GreetingMessage → Write, chained with Result combinators.
+//
+// This is a thin functional alternative to the GreetUseCase struct for
+// library consumers who want one call without constructing a use case.
+// Unlike GreetUseCase it takes the writer as a plain interface value
+// (dynamic dispatch) - use the struct form when static dispatch matters.
+//
+// Railway-Oriented Flow:
+//   - CreatePerson fails -> ValidationError propagates, nothing is written
+//   - Write fails        -> InfrastructureError propagates
+//   - Both succeed       -> Ok(Unit)
+//
+// Contract:
+//   - Pre: ctx is non-nil (use context.Background() if no cancellation needed)
+//   - Post: Returns Ok(Unit) if the greeting was written
+//   - Post: Returns Err(ValidationError) for an invalid name (no write occurs)
+//   - Post: Returns Err(InfrastructureError) if the write fails
+func Greet(ctx context.Context, name string, w outbound.WriterPort) domerr.Result[model.Unit] {
+	return domerr.AndThenTo(
+		valueobject.CreatePerson(name),
+		func(person valueobject.Person) domerr.Result[model.Unit] {
+			return w.Write(ctx, person.GreetingMessage())
+		})
+}
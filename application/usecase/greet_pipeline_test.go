@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// failWriter always returns an InfrastructureError.
+type failWriter struct{}
+
+func (failWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](domerr.NewInfrastructureError("sink unavailable"))
+}
+
+// TestApplicationUsecaseGreetPipeline tests the functional Greet pipeline.
+func TestApplicationUsecaseGreetPipeline(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Pipeline")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Valid name writes the greeting and returns Ok
+	// ========================================================================
+
+	writer := &captureWriter{}
+	r1 := Greet(ctx, "Alice", writer)
+	tf.RunTest("Valid name - returns Ok", r1.IsOk())
+	tf.RunTest("Valid name - greeting written",
+		len(writer.messages) == 1 && writer.messages[0] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Invalid name short-circuits before the write
+	// ========================================================================
+
+	unused := &captureWriter{}
+	r2 := Greet(ctx, "", unused)
+	tf.RunTest("Invalid name - returns Err", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("Invalid name - kind is ValidationError",
+			r2.ErrorInfo().Kind == domerr.ValidationError)
+	}
+	tf.RunTest("Invalid name - nothing written", len(unused.messages) == 0)
+
+	// ========================================================================
+	// Test: Failing writer surfaces InfrastructureError
+	// ========================================================================
+
+	r3 := Greet(ctx, "Bob", failWriter{})
+	tf.RunTest("Failing writer - returns Err", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("Failing writer - kind is InfrastructureError",
+			r3.ErrorInfo().Kind == domerr.InfrastructureError)
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,343 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// captureWriter records written messages for assertions. The application
+// layer cannot import infrastructure, so tests use this local test double.
+type captureWriter struct {
+	messages []string
+}
+
+func (cw *captureWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	cw.messages = append(cw.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationUsecaseGreetDecoration tests the greeting decoration option.
+func TestApplicationUsecaseGreetDecoration(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Decoration")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Default construction produces an undecorated greeting
+	// ========================================================================
+
+	plain := &captureWriter{}
+	uc1 := NewGreetUseCase[*captureWriter](plain)
+	r1 := uc1.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Undecorated - Execute returns Ok", r1.IsOk())
+	tf.RunTest("Undecorated - exactly one write", len(plain.messages) == 1)
+	tf.RunTest("Undecorated - message has no decoration",
+		len(plain.messages) == 1 && plain.messages[0] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Decoration is appended after the terminal "!"
+	// ========================================================================
+
+	decorated := &captureWriter{}
+	uc2 := NewGreetUseCaseWithConfig[*captureWriter](decorated,
+		GreetConfig{Decoration: " 👋"})
+	r2 := uc2.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Decorated - Execute returns Ok", r2.IsOk())
+	tf.RunTest("Decorated - decoration appended",
+		len(decorated.messages) == 1 && decorated.messages[0] == "Hello, Alice! 👋")
+
+	// ========================================================================
+	// Test: Validation errors still surface with decoration configured
+	// ========================================================================
+
+	failing := &captureWriter{}
+	uc3 := NewGreetUseCaseWithConfig[*captureWriter](failing,
+		GreetConfig{Decoration: " 👋"})
+	r3 := uc3.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Decorated invalid name - Execute returns Err", r3.IsError())
+	tf.RunTest("Decorated invalid name - nothing written", len(failing.messages) == 0)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestApplicationUsecaseGreetSilent tests the silent-mode option.
+func TestApplicationUsecaseGreetSilent(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Silent")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Silent mode validates but produces no output
+	// ========================================================================
+
+	silent := &captureWriter{}
+	uc1 := NewGreetUseCaseWithConfig[*captureWriter](silent,
+		GreetConfig{Silent: true})
+	r1 := uc1.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Silent valid name - Execute returns Ok", r1.IsOk())
+	tf.RunTest("Silent valid name - nothing written", len(silent.messages) == 0)
+
+	// ========================================================================
+	// Test: Validation errors still surface in silent mode
+	// ========================================================================
+
+	r2 := uc1.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Silent invalid name - Execute returns Err", r2.IsError())
+	tf.RunTest("Silent invalid name - error is validation",
+		r2.IsError() && r2.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Silent invalid name - nothing written", len(silent.messages) == 0)
+
+	// ========================================================================
+	// Test: Non-silent config still writes (control)
+	// ========================================================================
+
+	loud := &captureWriter{}
+	uc2 := NewGreetUseCaseWithConfig[*captureWriter](loud, GreetConfig{})
+	r3 := uc2.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Non-silent - Execute returns Ok", r3.IsOk())
+	tf.RunTest("Non-silent - greeting written", len(loud.messages) == 1)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestApplicationUsecaseGreetAnonymous tests the opt-in anonymous default.
+func TestApplicationUsecaseGreetAnonymous(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Anonymous")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: With the default set, an empty name greets the substitute
+	// ========================================================================
+
+	capture := &captureWriter{}
+	uc := NewGreetUseCaseWithConfig[*captureWriter](capture,
+		GreetConfig{AnonymousName: "stranger"})
+
+	r1 := uc.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Anonymous set - empty name succeeds", r1.IsOk())
+	tf.RunTest("Anonymous set - substitute greeted",
+		len(capture.messages) == 1 && capture.messages[0] == "Hello, stranger!")
+
+	// ========================================================================
+	// Test: Non-empty names are never substituted
+	// ========================================================================
+
+	r2 := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Anonymous set - real name untouched",
+		r2.IsOk() && capture.messages[1] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Without the default, the domain stays strict
+	// ========================================================================
+
+	strictUC := NewGreetUseCase[*captureWriter](&captureWriter{})
+	r3 := strictUC.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Anonymous unset - empty name still rejected",
+		r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestApplicationUsecaseGreetFormat tests the greeting template option.
+func TestApplicationUsecaseGreetFormat(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Format")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: A custom template substitutes the validated name
+	// ========================================================================
+
+	capture := &captureWriter{}
+	uc := NewGreetUseCaseWithFormat[*captureWriter](capture, "Hi {name} :)")
+
+	r1 := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Custom format - Ok", r1.IsOk())
+	tf.RunTest("Custom format - template applied",
+		len(capture.messages) == 1 && capture.messages[0] == "Hi Alice :)")
+
+	// ========================================================================
+	// Test: An empty Format keeps the classic greeting
+	// ========================================================================
+
+	classic := &captureWriter{}
+	r2 := NewGreetUseCaseWithConfig[*captureWriter](classic, GreetConfig{}).
+		Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("Default format - classic greeting",
+		r2.IsOk() && classic.messages[0] == "Hello, Bob!")
+
+	// ========================================================================
+	// Test: A template missing the placeholder is emitted verbatim
+	// ========================================================================
+
+	fixed := &captureWriter{}
+	r3 := NewGreetUseCaseWithFormat[*captureWriter](fixed, "Welcome aboard!").
+		Execute(ctx, command.NewGreetCommand("Carol"))
+	tf.RunTest("No placeholder - format emitted verbatim",
+		r3.IsOk() && fixed.messages[0] == "Welcome aboard!")
+
+	// ========================================================================
+	// Test: Validation still runs before formatting
+	// ========================================================================
+
+	r4 := uc.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Custom format - empty name still rejected",
+		r4.IsError() && r4.ErrorInfo().Kind == domerr.ValidationError)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestApplicationUsecaseGreetLanguage tests per-command localized greetings.
+func TestApplicationUsecaseGreetLanguage(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Language")
+	ctx := context.Background()
+
+	capture := &captureWriter{}
+	uc := NewGreetUseCase[*captureWriter](capture)
+
+	// ========================================================================
+	// Test: The command's language selects the greeting
+	// ========================================================================
+
+	r1 := uc.Execute(ctx, command.NewGreetCommandInLanguage("Alice", valueobject.French))
+	tf.RunTest("French - Ok", r1.IsOk())
+	tf.RunTest("French - localized greeting written",
+		len(capture.messages) == 1 && capture.messages[0] == "Bonjour, Alice !")
+
+	// ========================================================================
+	// Test: The plain constructor still greets in English
+	// ========================================================================
+
+	r2 := uc.Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("Default - English greeting",
+		r2.IsOk() && capture.messages[1] == "Hello, Bob!")
+
+	// ========================================================================
+	// Test: A configured Format wins over the command's language
+	// ========================================================================
+
+	formatted := &captureWriter{}
+	r3 := NewGreetUseCaseWithFormat[*captureWriter](formatted, "Hi {name} :)").
+		Execute(ctx, command.NewGreetCommandInLanguage("Carol", valueobject.German))
+	tf.RunTest("Format precedence - template wins",
+		r3.IsOk() && formatted.messages[0] == "Hi Carol :)")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestApplicationUsecaseGreetTransform tests greeting transforms.
+func TestApplicationUsecaseGreetTransform(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Transform")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: A configured transform rewrites the greeting before the write
+	// ========================================================================
+
+	capture := &captureWriter{}
+	uc := NewGreetUseCaseWithConfig[*captureWriter](capture,
+		GreetConfig{Transform: strings.ToUpper})
+
+	r1 := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("ToUpper - Ok", r1.IsOk())
+	tf.RunTest("ToUpper - greeting shouted",
+		len(capture.messages) == 1 && capture.messages[0] == "HELLO, ALICE!")
+
+	// ========================================================================
+	// Test: Unicode names case-fold correctly
+	// ========================================================================
+
+	r2 := uc.Execute(ctx, command.NewGreetCommand("josé garcía"))
+	tf.RunTest("Unicode - Ok", r2.IsOk())
+	tf.RunTest("Unicode - accented runes upper-cased",
+		capture.messages[1] == "HELLO, JOSÉ GARCÍA!")
+
+	// ========================================================================
+	// Test: Nil transform is the identity
+	// ========================================================================
+
+	plain := &captureWriter{}
+	r3 := NewGreetUseCase[*captureWriter](plain).
+		Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("Default - greeting untouched",
+		r3.IsOk() && plain.messages[0] == "Hello, Bob!")
+
+	// ========================================================================
+	// Test: The per-command uppercase request overrides the transform
+	// ========================================================================
+
+	custom := &captureWriter{}
+	reverseConfig := GreetConfig{Transform: func(s string) string { return s + s }}
+	r4 := NewGreetUseCaseWithConfig[*captureWriter](custom, reverseConfig).
+		Execute(ctx, command.GreetCommand{Name: "Carol", Uppercase: true})
+	tf.RunTest("Override - uppercase wins over configured transform",
+		r4.IsOk() && custom.messages[0] == "HELLO, CAROL!")
+
+	// ========================================================================
+	// Test: Transform applies after decoration
+	// ========================================================================
+
+	decorated := &captureWriter{}
+	r5 := NewGreetUseCaseWithConfig[*captureWriter](decorated,
+		GreetConfig{Decoration: " yay", Transform: strings.ToUpper}).
+		Execute(ctx, command.NewGreetCommand("Dave"))
+	tf.RunTest("Decoration - transformed with the greeting",
+		r5.IsOk() && decorated.messages[0] == "HELLO, DAVE! YAY")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestApplicationUsecaseGreetValidate tests write-free validation.
+func TestApplicationUsecaseGreetValidate(t *testing.T) {
+	tf := test.New("Application.Usecase.Greet.Validate")
+	ctx := context.Background()
+
+	capture := &captureWriter{}
+	uc := NewGreetUseCase[*captureWriter](capture)
+
+	// ========================================================================
+	// Test: A valid name validates Ok without writing
+	// ========================================================================
+
+	r1 := uc.Validate(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Valid - Ok", r1.IsOk())
+	tf.RunTest("Valid - nothing written", len(capture.messages) == 0)
+
+	// ========================================================================
+	// Test: Invalid names fail with ValidationError, still without writing
+	// ========================================================================
+
+	r2 := uc.Validate(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Empty - ValidationError",
+		r2.IsError() && r2.ErrorInfo().Kind == domerr.ValidationError)
+
+	r3 := uc.Validate(ctx, command.NewGreetCommand(strings.Repeat("x", 101)))
+	tf.RunTest("Too long - ValidationError",
+		r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Failures - nothing written", len(capture.messages) == 0)
+
+	// ========================================================================
+	// Test: The anonymous default applies before validation
+	// ========================================================================
+
+	anon := NewGreetUseCaseWithConfig[*captureWriter](&captureWriter{},
+		GreetConfig{AnonymousName: "stranger"})
+	r4 := anon.Validate(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Anonymous - empty name validates Ok", r4.IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Middleware decoration for the greet input port
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/inbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// GreetMiddleware decorates a GreetPort with cross-cutting behavior.
+// Middlewares compose by nesting: m1(m2(useCase)) runs m1 outermost.
+type GreetMiddleware func(next inbound.GreetPort) inbound.GreetPort
+
+// greetPortFunc adapts a plain function to the GreetPort interface, the
+// usual http.HandlerFunc trick applied to the greet input port.
+type greetPortFunc func(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit]
+
+// Execute implements inbound.GreetPort.
+func (f greetPortFunc) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	return f(ctx, cmd)
+}
+
+// RecoverMiddleware converts any panic escaping the wrapped port into an
+// Err(InfrastructureError) carrying the panic value, so a bug deep in the
+// domain or orchestration degrades into a failed Result instead of
+// crashing the process.
+//
+// ConsoleWriter already recovers around I/O; this extends the same
+// exception boundary over the whole Execute call.
+//
+// Example:
+//
+//	uc := usecase.RecoverMiddleware(usecase.NewGreetUseCase[*Writer](writer))
+func RecoverMiddleware(next inbound.GreetPort) inbound.GreetPort {
+	return greetPortFunc(func(ctx context.Context, cmd command.GreetCommand) (result domerr.Result[model.Unit]) {
+		defer func() {
+			if r := recover(); r != nil {
+				result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+					fmt.Sprintf("greet panicked: %v", r)))
+			}
+		}()
+		return next.Execute(ctx, cmd)
+	})
+}
+
+// TimingMiddleware measures how long the wrapped Execute takes and, on
+// failure, attaches the elapsed wall-clock time to the error's structured
+// metadata under "duration_ms" (see ErrorType.WithField). Successful
+// results pass through untouched, so the happy path pays only the cost of
+// two clock reads.
+//
+// Sub-millisecond failures record "0"; the field signals "how long before
+// it failed", not a high-resolution profile.
+//
+// Example:
+//
+//	uc := usecase.TimingMiddleware(usecase.NewGreetUseCase[*Writer](writer))
+func TimingMiddleware(next inbound.GreetPort) inbound.GreetPort {
+	return greetPortFunc(func(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+		start := time.Now()
+		result := next.Execute(ctx, cmd)
+		if result.IsError() {
+			elapsed := time.Since(start).Milliseconds()
+			return domerr.Err[model.Unit](result.ErrorInfo().
+				WithField("duration_ms", strconv.FormatInt(elapsed, 10)))
+		}
+		return result
+	})
+}
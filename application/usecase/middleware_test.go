@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// panickingWriter panics on every write, simulating a bug past validation.
+type panickingWriter struct{}
+
+func (panickingWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	panic("writer exploded")
+}
+
+// TestApplicationUsecaseRecoverMiddleware tests the panic exception boundary.
+func TestApplicationUsecaseRecoverMiddleware(t *testing.T) {
+	tf := test.New("Application.Usecase.RecoverMiddleware")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: A panic inside Execute becomes an InfrastructureError Result
+	// ========================================================================
+
+	uc := RecoverMiddleware(NewGreetUseCase[panickingWriter](panickingWriter{}))
+	r1 := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Panic - process survives and error returned", r1.IsError())
+	tf.RunTest("Panic - kind is InfrastructureError",
+		r1.IsError() && r1.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("Panic - panic value captured in message",
+		r1.IsError() && strings.Contains(r1.ErrorInfo().Message, "writer exploded"))
+
+	// ========================================================================
+	// Test: Non-panicking paths pass through untouched
+	// ========================================================================
+
+	capture := &captureWriter{}
+	wrapped := RecoverMiddleware(NewGreetUseCase[*captureWriter](capture))
+	r2 := wrapped.Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("Success - Ok passes through", r2.IsOk())
+	tf.RunTest("Success - greeting still written",
+		len(capture.messages) == 1 && capture.messages[0] == "Hello, Bob!")
+
+	r3 := wrapped.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Validation failure - error passes through unchanged",
+		r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// slowFailingWriter sleeps before failing, giving the timing middleware a
+// measurable duration to record.
+type slowFailingWriter struct {
+	delay time.Duration
+}
+
+func (w slowFailingWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	time.Sleep(w.delay)
+	return domerr.Err[model.Unit](domerr.NewInfrastructureError("sink unavailable"))
+}
+
+// TestApplicationUsecaseTimingMiddleware tests duration enrichment of errors.
+func TestApplicationUsecaseTimingMiddleware(t *testing.T) {
+	tf := test.New("Application.Usecase.TimingMiddleware")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: A failed Execute carries a plausible duration_ms field
+	// ========================================================================
+
+	uc := TimingMiddleware(NewGreetUseCase[slowFailingWriter](
+		slowFailingWriter{delay: 20 * time.Millisecond}))
+	r1 := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Failure - error returned", r1.IsError())
+
+	ms, present := r1.ErrorInfo().Field("duration_ms")
+	tf.RunTest("Failure - duration_ms field present", present)
+	elapsed, parseErr := strconv.ParseInt(ms, 10, 64)
+	tf.RunTest("Failure - duration_ms is numeric", parseErr == nil)
+	tf.RunTest("Failure - duration is plausible (>= 20ms, < 5s)",
+		elapsed >= 20 && elapsed < 5000)
+	tf.RunTest("Failure - original kind and message preserved",
+		r1.ErrorInfo().Kind == domerr.InfrastructureError &&
+			r1.ErrorInfo().Message == "sink unavailable")
+
+	// ========================================================================
+	// Test: Successful Execute passes through untouched
+	// ========================================================================
+
+	capture := &captureWriter{}
+	wrapped := TimingMiddleware(NewGreetUseCase[*captureWriter](capture))
+	r2 := wrapped.Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("Success - Ok passes through", r2.IsOk())
+	tf.RunTest("Success - greeting still written",
+		len(capture.messages) == 1 && capture.messages[0] == "Hello, Bob!")
+
+	// ========================================================================
+	// Test: Composes with RecoverMiddleware (timing outermost)
+	// ========================================================================
+
+	chained := TimingMiddleware(RecoverMiddleware(
+		NewGreetUseCase[panickingWriter](panickingWriter{})))
+	r3 := chained.Execute(ctx, command.NewGreetCommand("Carol"))
+	_, timed := r3.ErrorInfo().Field("duration_ms")
+	tf.RunTest("Composed - recovered panic is also timed", r3.IsError() && timed)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: cli
+// Description: Composition root for the greeter CLI
+
+// Package cli wires the greeter CLI application together.
+//
+// This is the composition root: the single place where Infrastructure
+// adapters (ConsoleWriter) are instantiated and injected into Application
+// use cases (GreetUseCase), which are then handed to the Presentation layer
+// (GreetCommand) for argument handling and exit-code mapping.
+//
+// Architecture Notes:
+//   - Part of the BOOTSTRAP layer (outermost, composition root)
+//   - The only layer allowed to depend on every other layer
+//   - Writers are injectable so tests can run the full CLI in-process
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/bootstrap/cli"
+//
+//	func main() {
+//	    os.Exit(cli.Run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+//	}
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+	"github.com/abitofhelp/hybrid_lib_go/presentation/adapter/cli/command"
+	"github.com/abitofhelp/hybrid_lib_go/version"
+)
+
+// Run wires the application with the given output streams and executes the
+// greet command, returning the process exit code.
+//
+// The greeting is written to stdout; usage and error diagnostics go to
+// stderr. Both streams are injectable so integration tests can capture
+// output without spawning a process.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	// Version query: handled before any parsing so it always wins, even
+	// alongside otherwise-invalid arguments and without requiring a name.
+	if hasFlag(args, "--version") || hasFlag(args, "-v") {
+		fmt.Fprintf(stdout, "greeter %s\n", effectiveVersion())
+		return command.ExitSuccess
+	}
+
+	// Help request: the full usage block goes to stdout with exit 0 - an
+	// answered question, not an error - unlike the short usage that the
+	// presentation layer prints to stderr on malformed arguments.
+	if hasFlag(args, "--help") || hasFlag(args, "-h") {
+		fmt.Fprint(stdout, fullUsage)
+		return command.ExitSuccess
+	}
+
+	// Wiring-affecting flags are consumed here, before the presentation
+	// layer sees the remaining arguments, because they change how the use
+	// case is constructed rather than how one invocation behaves.
+	config, rest, err := extractConfig(args)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return command.ExitValidation
+	}
+
+	// Graceful shutdown: SIGTERM/SIGINT cancel the context, so an
+	// interrupted run unwinds through the normal cancellation path and the
+	// buffered wiring below still flushes whatever was written.
+	ctx, stop := NotifyShutdown(ctx)
+	defer stop()
+
+	// Bounded runs: --timeout places a deadline over the whole operation so
+	// a slow writer aborts instead of hanging. Zero means no deadline.
+	if config.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.timeout)
+		defer cancel()
+	}
+
+	// Diagnostics mode: dump the effective configuration as JSON and exit
+	// without greeting. Handled here because only the composition root
+	// knows the resolved wiring.
+	if hasFlag(rest, "--diagnostics") {
+		return printDiagnostics(config, stdout, stderr)
+	}
+
+	// Buffered wiring: greetings pass through a BufferedWriter that must be
+	// flushed after the command completes.
+	if config.buffered {
+		var writer *adapter.BufferedWriter
+		if config.bufferSize > 0 {
+			writer = adapter.NewBufferedWriterSize(stdout, config.bufferSize)
+		} else {
+			writer = adapter.NewBufferedWriter(stdout)
+		}
+		uc := usecase.NewGreetUseCaseWithConfig[*adapter.BufferedWriter](writer, config.greet)
+		cmd := command.NewGreetCommand(uc, stdout, stderr)
+
+		code := cmd.Run(ctx, rest)
+		if flushResult := writer.Flush(); flushResult.IsError() && code == command.ExitSuccess {
+			fmt.Fprintf(stderr, "Error: %s\n", flushResult.ErrorInfo().Message)
+			code = command.ExitInfrastructure
+		}
+		return code
+	}
+
+	// Named wiring: --output selects a registered writer factory by name.
+	// The factory owns its destination, so the injected stdout only carries
+	// informational output in this mode.
+	if config.output != "" {
+		writerResult := adapter.NewWriterByName(config.output, nil)
+		if writerResult.IsError() {
+			fmt.Fprintf(stderr, "Error: %s\n", writerResult.ErrorInfo().Message)
+			return command.ExitValidation
+		}
+		uc := usecase.NewGreetUseCaseWithConfig[outbound.WriterPort](writerResult.Value(), config.greet)
+		cmd := command.NewGreetCommand(uc, stdout, stderr)
+		return cmd.Run(ctx, rest)
+	}
+
+	// Default wiring: unbuffered console writer over the injected stdout
+	writer := adapter.NewWriter(stdout)
+	uc := usecase.NewGreetUseCaseWithConfig[*adapter.ConsoleWriter](writer, config.greet)
+	cmd := command.NewGreetCommand(uc, stdout, stderr)
+
+	return cmd.Run(ctx, rest)
+}
+
+// RunInProcess runs the fully wired CLI with captured output streams and
+// returns whatever appeared on stdout and stderr alongside the exit code.
+//
+// This is the programmatic entry point for tests and embedders: it exercises
+// the same composition root as the greeter binary without spawning a process,
+// so no built binary needs to be located on disk.
+//
+// Example:
+//
+//	stdout, stderr, code := cli.RunInProcess([]string{"Alice"})
+func RunInProcess(args []string) (stdout, stderr string, code int) {
+	var out, errOut bytes.Buffer
+	code = Run(context.Background(), args, &out, &errOut)
+	return out.String(), errOut.String(), code
+}
+
+// emojiDecoration is appended to greetings when --emoji is set.
+const emojiDecoration = " 👋"
+
+// fullUsage is the complete help text printed by --help. The composition
+// root owns it because only here are both the wiring flags (consumed by
+// extractConfig) and the presentation flags visible in one place.
+const fullUsage = `greeter - write a friendly greeting for each name
+
+Usage:
+  greeter [options] <name> [<name>...]
+
+Greets every name on stdout as "Hello, <name>!". Several names run as a
+batch: every name is attempted and a per-kind outcome summary is printed
+to stderr.
+
+Options:
+  --strict            reject whitespace-only and untrimmed names
+  --names-from=LIST   greet a comma-separated list of names
+  --echo              dump each received name (hex and decoded) to stderr
+  --lang=CODE         greeting language (en, fr, es, de)
+  --upper, --uppercase  upper-case the greeting
+  --quiet             no output; validate names and exit
+  --emoji             decorate greetings with a waving hand
+  --anonymous=NAME    greet NAME when no name is given
+  --buffered          buffer output, flushing once at the end
+  --buffer-size=N     buffer output with an N-byte buffer
+  --timeout=D         abort if the run exceeds duration D (e.g. 2s)
+  --output=NAME       route greetings through the registered writer NAME
+  --diagnostics       print the effective configuration as JSON and exit
+  -v, --version       print the version and exit
+  -h, --help          print this help and exit
+
+Examples:
+  greeter Alice
+  greeter --emoji Alice Bob
+  greeter --names-from=Alice,Bob --strict
+  greeter --buffered --timeout=5s Carol
+`
+
+// buildVersion can be stamped at link time to override the library version
+// for packaged binaries:
+//
+//	go build -ldflags "-X github.com/abitofhelp/hybrid_lib_go/bootstrap/cli.buildVersion=1.2.3"
+//
+// Left empty, --version reports the library's own version constant.
+var buildVersion = ""
+
+// effectiveVersion returns the link-time build version when stamped,
+// otherwise the library version.
+func effectiveVersion() string {
+	if buildVersion != "" {
+		return buildVersion
+	}
+	return version.Version
+}
+
+// diagnostics is the JSON structure emitted by --diagnostics so users can
+// report bugs with their exact effective settings.
+type diagnostics struct {
+	Version       string `json:"version"`
+	Writer        string `json:"writer"`
+	BufferSize    int    `json:"buffer_size,omitempty"`
+	Decoration    string `json:"decoration,omitempty"`
+	MaxNameLength int    `json:"max_name_length"`
+}
+
+// hasFlag reports whether args contains the exact flag token.
+func hasFlag(args []string, flag string) bool {
+	for _, arg := range args {
+		if arg == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// printDiagnostics writes the effective configuration as JSON to stdout
+// and returns the process exit code.
+func printDiagnostics(config bootConfig, stdout, stderr io.Writer) int {
+	writerName := "console"
+	if config.buffered {
+		writerName = "buffered"
+	}
+
+	diag := diagnostics{
+		Version:       version.Version,
+		Writer:        writerName,
+		BufferSize:    config.bufferSize,
+		Decoration:    config.greet.Decoration,
+		MaxNameLength: valueobject.MaxNameLength,
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(diag); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return command.ExitInfrastructure
+	}
+	return command.ExitSuccess
+}
+
+// bootConfig collects composition-level settings extracted from the
+// command line before wiring.
+type bootConfig struct {
+	greet      usecase.GreetConfig
+	buffered   bool
+	bufferSize int           // 0 means bufio's default size
+	timeout    time.Duration // 0 means no deadline
+	output     string        // "" means the default console wiring
+}
+
+// extractConfig consumes composition-level flags from args, returning the
+// resulting wiring config and the remaining arguments. It returns an error
+// for malformed flag values (e.g. a non-positive --buffer-size).
+func extractConfig(args []string) (bootConfig, []string, error) {
+	var config bootConfig
+	rest := make([]string, 0, len(args))
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--emoji":
+			config.greet.Decoration = emojiDecoration
+		case arg == "--buffered":
+			config.buffered = true
+		case arg == "--buffer-size" || strings.HasPrefix(arg, "--buffer-size="):
+			value := strings.TrimPrefix(arg, "--buffer-size=")
+			if value == arg { // separate-token form: --buffer-size N
+				if i+1 >= len(args) {
+					return config, nil, fmt.Errorf("--buffer-size requires a value")
+				}
+				i++
+				value = args[i]
+			}
+			size, err := strconv.Atoi(value)
+			if err != nil || size <= 0 {
+				return config, nil, fmt.Errorf("--buffer-size must be a positive integer, got %q", value)
+			}
+			config.buffered = true
+			config.bufferSize = size
+		case arg == "--timeout" || strings.HasPrefix(arg, "--timeout="):
+			value := strings.TrimPrefix(arg, "--timeout=")
+			if value == arg { // separate-token form: --timeout D
+				if i+1 >= len(args) {
+					return config, nil, fmt.Errorf("--timeout requires a value")
+				}
+				i++
+				value = args[i]
+			}
+			d, err := time.ParseDuration(value)
+			if err != nil || d <= 0 {
+				return config, nil, fmt.Errorf("--timeout must be a positive duration, got %q", value)
+			}
+			config.timeout = d
+		case arg == "--anonymous" || strings.HasPrefix(arg, "--anonymous="):
+			value := strings.TrimPrefix(arg, "--anonymous=")
+			if value == arg { // separate-token form: --anonymous NAME
+				if i+1 >= len(args) {
+					return config, nil, fmt.Errorf("--anonymous requires a value")
+				}
+				i++
+				value = args[i]
+			}
+			if value == "" {
+				return config, nil, fmt.Errorf("--anonymous requires a non-empty name")
+			}
+			config.greet.AnonymousName = value
+		case arg == "--output" || strings.HasPrefix(arg, "--output="):
+			value := strings.TrimPrefix(arg, "--output=")
+			if value == arg { // separate-token form: --output NAME
+				if i+1 >= len(args) {
+					return config, nil, fmt.Errorf("--output requires a value")
+				}
+				i++
+				value = args[i]
+			}
+			config.output = value
+		default:
+			rest = append(rest, arg)
+		}
+	}
+	return config, rest, nil
+}
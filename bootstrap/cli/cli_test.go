@@ -0,0 +1,648 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// Package cli_test provides in-process integration tests for the greeter CLI,
+// running the full composition root with captured output streams.
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/bootstrap/cli"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+	"github.com/abitofhelp/hybrid_lib_go/version"
+)
+
+// runCLI runs the wired CLI in-process and captures stdout/stderr.
+func runCLI(args ...string) (stdout, stderr string, code int) {
+	return cli.RunInProcess(args)
+}
+
+// TestBootstrapCliGreet tests the wired CLI end-to-end in-process.
+func TestBootstrapCliGreet(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.Greet")
+
+	// ========================================================================
+	// Test: Valid name greets on stdout with exit 0
+	// ========================================================================
+
+	stdout, stderr, code := runCLI("Alice")
+	tf.RunTest("Valid name - exit code 0", code == 0)
+	tf.RunTest("Valid name - greeting on stdout", stdout == "Hello, Alice!\n")
+	tf.RunTest("Valid name - stderr empty", stderr == "")
+
+	// ========================================================================
+	// Test: Whitespace-only name is lenient by default (exit 0)
+	// ========================================================================
+
+	stdout, stderr, code = runCLI("   ")
+	tf.RunTest("Whitespace default - exit code 0", code == 0)
+	tf.RunTest("Whitespace default - verbatim greeting", stdout == "Hello,    !\n")
+
+	// ========================================================================
+	// Test: Whitespace-only name fails under --strict (exit 1)
+	// ========================================================================
+
+	stdout, stderr, code = runCLI("--strict", "   ")
+	tf.RunTest("Whitespace strict - exit code 1", code == 1)
+	tf.RunTest("Whitespace strict - nothing on stdout", stdout == "")
+	tf.RunTest("Whitespace strict - error on stderr",
+		strings.Contains(stderr, "whitespace-only"))
+
+	// ========================================================================
+	// Test: Untrimmed name fails under --strict, valid name passes
+	// ========================================================================
+
+	_, stderr, code = runCLI("--strict", " Alice ")
+	tf.RunTest("Untrimmed strict - exit code 1", code == 1)
+	tf.RunTest("Untrimmed strict - error mentions whitespace",
+		strings.Contains(stderr, "whitespace"))
+
+	stdout, _, code = runCLI("--strict", "Alice")
+	tf.RunTest("Valid strict - exit code 0", code == 0)
+	tf.RunTest("Valid strict - greeting on stdout", stdout == "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Missing name prints usage to stderr with exit 1
+	// ========================================================================
+
+	stdout, stderr, code = runCLI()
+	tf.RunTest("Missing name - exit code 1", code == 1)
+	tf.RunTest("Missing name - usage on stderr", strings.Contains(stderr, "Usage:"))
+	tf.RunTest("Missing name - nothing on stdout", stdout == "")
+
+	// ========================================================================
+	// Test: --emoji appends the decoration
+	// ========================================================================
+
+	stdout, _, code = runCLI("--emoji", "Alice")
+	tf.RunTest("Emoji flag - exit code 0", code == 0)
+	tf.RunTest("Emoji flag - decoration appended", stdout == "Hello, Alice! 👋\n")
+
+	stdout, _, code = runCLI("Alice")
+	tf.RunTest("No emoji flag - undecorated greeting", stdout == "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: --buffered and --buffer-size produce correct, flushed output
+	// ========================================================================
+
+	stdout, _, code = runCLI("--buffered", "Alice")
+	tf.RunTest("Buffered default size - exit code 0", code == 0)
+	tf.RunTest("Buffered default size - output flushed", stdout == "Hello, Alice!\n")
+
+	stdout, _, code = runCLI("--buffer-size=8", "Alice")
+	tf.RunTest("Small buffer - exit code 0", code == 0)
+	tf.RunTest("Small buffer - output correct", stdout == "Hello, Alice!\n")
+
+	stdout, _, code = runCLI("--buffer-size", "65536", "Alice")
+	tf.RunTest("Large buffer - exit code 0", code == 0)
+	tf.RunTest("Large buffer - output correct", stdout == "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Invalid --buffer-size values error out
+	// ========================================================================
+
+	_, stderr, code = runCLI("--buffer-size=0", "Alice")
+	tf.RunTest("Zero buffer size - exit code 1", code == 1)
+	tf.RunTest("Zero buffer size - error mentions positive",
+		strings.Contains(stderr, "positive"))
+
+	_, stderr, code = runCLI("--buffer-size=abc", "Alice")
+	tf.RunTest("Non-numeric buffer size - exit code 1", code == 1)
+
+	_, _, code = runCLI("Alice", "--buffer-size")
+	tf.RunTest("Missing buffer size value - exit code 1", code == 1)
+
+	// ========================================================================
+	// Test: Batch mode greets every valid name and summarizes to stderr
+	// ========================================================================
+
+	stdout, stderr, code = runCLI("Alice", "Bob", "Carol")
+	tf.RunTest("Batch all valid - exit code 0", code == 0)
+	tf.RunTest("Batch all valid - all greetings on stdout",
+		stdout == "Hello, Alice!\nHello, Bob!\nHello, Carol!\n")
+	tf.RunTest("Batch all valid - summary on stderr",
+		strings.Contains(stderr, "succeeded: 3, validation errors: 0, infrastructure errors: 0"))
+
+	// ========================================================================
+	// Test: Batch mode with invalid names still greets the valid ones
+	// ========================================================================
+
+	longName := strings.Repeat("x", 101)
+	stdout, stderr, code = runCLI("Alice", longName, "Bob", "")
+	tf.RunTest("Batch mixed - exit code 1", code == 1)
+	tf.RunTest("Batch mixed - valid names greeted",
+		strings.Contains(stdout, "Hello, Alice!") && strings.Contains(stdout, "Hello, Bob!"))
+	tf.RunTest("Batch mixed - summary counts failures",
+		strings.Contains(stderr, "succeeded: 2, validation errors: 2, infrastructure errors: 0"))
+
+	// ========================================================================
+	// Test: --names-from greets a comma-separated list with trimming
+	// ========================================================================
+
+	stdout, stderr, code = runCLI("--names-from", "Alice, Bob ,Carol")
+	tf.RunTest("Names-from valid - exit code 0", code == 0)
+	tf.RunTest("Names-from valid - trimmed names greeted",
+		stdout == "Hello, Alice!\nHello, Bob!\nHello, Carol!\n")
+	tf.RunTest("Names-from valid - summary on stderr",
+		strings.Contains(stderr, "succeeded: 3, validation errors: 0, infrastructure errors: 0"))
+
+	// ========================================================================
+	// Test: --names-from with empty entries counts them as validation errors
+	// ========================================================================
+
+	stdout, stderr, code = runCLI("--names-from=Alice,,Bob, ")
+	tf.RunTest("Names-from mixed - exit code 1", code == 1)
+	tf.RunTest("Names-from mixed - valid names still greeted",
+		strings.Contains(stdout, "Hello, Alice!") && strings.Contains(stdout, "Hello, Bob!"))
+	tf.RunTest("Names-from mixed - empty entries counted",
+		strings.Contains(stderr, "succeeded: 2, validation errors: 2, infrastructure errors: 0"))
+
+	_, _, code = runCLI("--names-from")
+	tf.RunTest("Names-from missing value - exit code 1", code == 1)
+
+	// ========================================================================
+	// Test: --timeout with ample headroom still succeeds
+	// ========================================================================
+
+	stdout, _, code = runCLI("--timeout=5s", "Alice")
+	tf.RunTest("Generous timeout - exit code 0", code == 0)
+	tf.RunTest("Generous timeout - greeting on stdout", stdout == "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: An immediately-expiring timeout aborts the write (non-zero exit)
+	// ========================================================================
+
+	stdout, stderr, code = runCLI("--timeout=1ns", "Alice")
+	tf.RunTest("Expired timeout - exit code 2", code == 2)
+	tf.RunTest("Expired timeout - nothing greeted", stdout == "")
+	tf.RunTest("Expired timeout - cancellation on stderr",
+		strings.Contains(stderr, "cancelled"))
+
+	// ========================================================================
+	// Test: Invalid --timeout values error out
+	// ========================================================================
+
+	_, stderr, code = runCLI("--timeout=abc", "Alice")
+	tf.RunTest("Malformed timeout - exit code 1", code == 1)
+	tf.RunTest("Malformed timeout - error mentions duration",
+		strings.Contains(stderr, "duration"))
+
+	_, _, code = runCLI("--timeout=-1s", "Alice")
+	tf.RunTest("Negative timeout - exit code 1", code == 1)
+
+	_, _, code = runCLI("Alice", "--timeout")
+	tf.RunTest("Missing timeout value - exit code 1", code == 1)
+
+	// ========================================================================
+	// Test: --diagnostics prints valid JSON and exits 0 without greeting
+	// ========================================================================
+
+	stdout, _, code = runCLI("--diagnostics")
+	tf.RunTest("Diagnostics - exit code 0", code == 0)
+	var diag map[string]any
+	jsonErr := json.Unmarshal([]byte(stdout), &diag)
+	tf.RunTest("Diagnostics - output is valid JSON", jsonErr == nil)
+	if jsonErr == nil {
+		tf.RunTest("Diagnostics - contains version",
+			diag["version"] == version.Version)
+		tf.RunTest("Diagnostics - contains max name length",
+			diag["max_name_length"] == float64(valueobject.MaxNameLength))
+		tf.RunTest("Diagnostics - default writer is console",
+			diag["writer"] == "console")
+	}
+	tf.RunTest("Diagnostics - no greeting emitted",
+		!strings.Contains(stdout, "Hello"))
+
+	// Diagnostics reflects wiring flags
+	stdout, _, code = runCLI("--buffered", "--emoji", "--diagnostics")
+	tf.RunTest("Diagnostics with flags - exit code 0", code == 0)
+	var diag2 map[string]any
+	if json.Unmarshal([]byte(stdout), &diag2) == nil {
+		tf.RunTest("Diagnostics with flags - writer is buffered",
+			diag2["writer"] == "buffered")
+		tf.RunTest("Diagnostics with flags - decoration present",
+			diag2["decoration"] == " 👋")
+	}
+
+	// ========================================================================
+	// Test: Unknown flag errors with usage
+	// ========================================================================
+
+	_, stderr, code = runCLI("--bogus", "Alice")
+	tf.RunTest("Unknown flag - exit code 1", code == 1)
+	tf.RunTest("Unknown flag - error on stderr", strings.Contains(stderr, "unknown flag"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliRunInProcess tests the programmatic in-process entry point.
+func TestBootstrapCliRunInProcess(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.RunInProcess")
+
+	// ========================================================================
+	// Test: Success path - valid name greets with exit 0
+	// ========================================================================
+
+	stdout, stderr, code := cli.RunInProcess([]string{"Alice"})
+	tf.RunTest("Success path - exit code 0", code == 0)
+	tf.RunTest("Success path - greeting captured", stdout == "Hello, Alice!\n")
+	tf.RunTest("Success path - stderr empty", stderr == "")
+
+	// ========================================================================
+	// Test: Error path - strict whitespace name fails with exit 1
+	// ========================================================================
+
+	stdout, stderr, code = cli.RunInProcess([]string{"--strict", "   "})
+	tf.RunTest("Error path - exit code 1", code == 1)
+	tf.RunTest("Error path - nothing on stdout", stdout == "")
+	tf.RunTest("Error path - error captured on stderr",
+		strings.Contains(stderr, "whitespace-only"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliOutputFlag tests writer selection via the factory registry.
+func TestBootstrapCliOutputFlag(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.OutputFlag")
+
+	// ========================================================================
+	// Test: An unknown writer name fails fast with exit 1
+	// ========================================================================
+
+	stdout, stderr, code := cli.RunInProcess([]string{"--output", "no-such-writer", "Alice"})
+	tf.RunTest("Unknown writer - exit code 1", code == 1)
+	tf.RunTest("Unknown writer - nothing on stdout", stdout == "")
+	tf.RunTest("Unknown writer - error names the writer",
+		strings.Contains(stderr, "no-such-writer"))
+
+	// ========================================================================
+	// Test: A missing value is a usage error
+	// ========================================================================
+
+	_, stderr, code = cli.RunInProcess([]string{"--output"})
+	tf.RunTest("Missing value - exit code 1", code == 1)
+	tf.RunTest("Missing value - error mentions the flag",
+		strings.Contains(stderr, "--output"))
+
+	// ========================================================================
+	// Test: A registered factory drives the greeting end to end
+	// ========================================================================
+
+	sink := adapter.NewSnapshotWriter()
+	adapter.RegisterWriterFactory("snapshot-test", func(cfg map[string]string) domerr.Result[outbound.WriterPort] {
+		return domerr.Ok[outbound.WriterPort](sink)
+	})
+	_, stderr, code = cli.RunInProcess([]string{"--output=snapshot-test", "Alice"})
+	tf.RunTest("Registered writer - exit code 0", code == 0)
+	tf.RunTest("Registered writer - stderr empty", stderr == "")
+	tf.RunTest("Registered writer - greeting routed through factory writer",
+		sink.Snapshot() == "Hello, Alice!\n")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliAnonymousFlag tests the --anonymous default-name wiring.
+func TestBootstrapCliAnonymousFlag(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.AnonymousFlag")
+
+	// ========================================================================
+	// Test: An empty name greets the anonymous default
+	// ========================================================================
+
+	stdout, _, code := runCLI("--anonymous", "stranger", "")
+	tf.RunTest("Anonymous - exit code 0", code == 0)
+	tf.RunTest("Anonymous - default greeted", stdout == "Hello, stranger!\n")
+
+	// ========================================================================
+	// Test: A real name is never substituted
+	// ========================================================================
+
+	stdout, _, code = runCLI("--anonymous=stranger", "Alice")
+	tf.RunTest("Anonymous with name - exit code 0", code == 0)
+	tf.RunTest("Anonymous with name - real name greeted", stdout == "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Without the flag an empty name still fails
+	// ========================================================================
+
+	_, stderr, code := runCLI("")
+	tf.RunTest("No flag - exit code 1", code == 1)
+	tf.RunTest("No flag - validation error reported",
+		strings.Contains(stderr, "empty"))
+
+	// ========================================================================
+	// Test: The flag requires a value
+	// ========================================================================
+
+	_, stderr, code = runCLI("--anonymous")
+	tf.RunTest("Missing value - exit code 1", code == 1)
+	tf.RunTest("Missing value - error mentions the flag",
+		strings.Contains(stderr, "--anonymous"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliEchoFlag tests the --echo name diagnostics.
+func TestBootstrapCliEchoFlag(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.EchoFlag")
+
+	// ========================================================================
+	// Test: With --echo the received bytes are dumped to stderr
+	// ========================================================================
+
+	stdout, stderr, code := cli.RunInProcess([]string{"--echo", "Alice"})
+	tf.RunTest("Echo - exit code 0", code == 0)
+	tf.RunTest("Echo - greeting unaffected", stdout == "Hello, Alice!\n")
+	tf.RunTest("Echo - decoded name on stderr",
+		strings.Contains(stderr, `echo: "Alice"`))
+	tf.RunTest("Echo - hex bytes on stderr",
+		strings.Contains(stderr, "41 6c 69 63 65"))
+
+	// ========================================================================
+	// Test: Invisible characters become visible in the dump
+	// ========================================================================
+
+	_, stderr, code = cli.RunInProcess([]string{"--echo", "A​B"})
+	tf.RunTest("Invisible rune - exit code 0", code == 0)
+	tf.RunTest("Invisible rune - zero-width space visible as hex",
+		strings.Contains(stderr, "41 e2 80 8b 42"))
+
+	// ========================================================================
+	// Test: Without --echo no diagnostic line appears
+	// ========================================================================
+
+	_, stderr, code = cli.RunInProcess([]string{"Alice"})
+	tf.RunTest("No flag - exit code 0", code == 0)
+	tf.RunTest("No flag - no echo line", !strings.Contains(stderr, "echo:"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliVersionFlag tests the --version short-circuit.
+func TestBootstrapCliVersionFlag(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.VersionFlag")
+
+	// ========================================================================
+	// Test: --version prints the version to stdout and exits 0
+	// ========================================================================
+
+	stdout, stderr, code := cli.RunInProcess([]string{"--version"})
+	tf.RunTest("Long flag - exit code 0", code == 0)
+	tf.RunTest("Long flag - version on stdout",
+		stdout == "greeter "+version.Version+"\n")
+	tf.RunTest("Long flag - stderr empty", stderr == "")
+
+	// ========================================================================
+	// Test: The -v short form behaves identically
+	// ========================================================================
+
+	stdout, _, code = cli.RunInProcess([]string{"-v"})
+	tf.RunTest("Short flag - exit code 0", code == 0)
+	tf.RunTest("Short flag - version on stdout",
+		strings.Contains(stdout, version.Version))
+
+	// ========================================================================
+	// Test: --version wins over the missing-name usage error
+	// ========================================================================
+
+	stdout, stderr, code = cli.RunInProcess([]string{"--version", "--bogus-flag"})
+	tf.RunTest("Precedence - exit code 0 despite bad args", code == 0)
+	tf.RunTest("Precedence - no usage error on stderr", stderr == "")
+	tf.RunTest("Precedence - version still printed",
+		strings.Contains(stdout, version.Version))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliShutdownFlush tests the cancel-then-flush shutdown hook.
+func TestBootstrapCliShutdownFlush(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.ShutdownFlush")
+
+	// ========================================================================
+	// Test: Cancellation flushes a buffered writer before settling
+	// ========================================================================
+
+	var buf bytes.Buffer
+	writer := adapter.NewBufferedWriter(&buf)
+	ctx, cancel := context.WithCancel(context.Background())
+	settled := cli.FlushOnShutdown(ctx, writer)
+
+	writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Before shutdown - output still buffered", buf.Len() == 0)
+
+	cancel()
+	<-settled
+	tf.RunTest("After shutdown - buffer flushed to the sink",
+		buf.String() == "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: A writer with neither Flush nor Close settles immediately
+	// ========================================================================
+
+	plainCtx, plainCancel := context.WithCancel(context.Background())
+	plain := cli.FlushOnShutdown(plainCtx, adapter.NewMemoryWriter())
+	plainCancel()
+	select {
+	case <-plain:
+		tf.RunTest("Plain writer - settled on cancellation", true)
+	case <-time.After(2 * time.Second):
+		tf.RunTest("Plain writer - settled on cancellation", false)
+	}
+
+	// ========================================================================
+	// Test: NotifyShutdown derives a cancellable context
+	// ========================================================================
+
+	notifyCtx, stop := cli.NotifyShutdown(context.Background())
+	tf.RunTest("NotifyShutdown - context starts live", notifyCtx.Err() == nil)
+	stop()
+	<-notifyCtx.Done()
+	tf.RunTest("NotifyShutdown - stop cancels the derived context",
+		notifyCtx.Err() != nil)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliHelpFlag tests the --help full-usage path.
+func TestBootstrapCliHelpFlag(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.HelpFlag")
+
+	// ========================================================================
+	// Test: --help prints the full usage to stdout with exit 0
+	// ========================================================================
+
+	stdout, stderr, code := cli.RunInProcess([]string{"--help"})
+	tf.RunTest("Long flag - exit code 0", code == 0)
+	tf.RunTest("Long flag - stderr empty", stderr == "")
+	tf.RunTest("Long flag - synopsis present",
+		strings.Contains(stdout, "Usage:") && strings.Contains(stdout, "greeter [options]"))
+	tf.RunTest("Long flag - examples present", strings.Contains(stdout, "Examples:"))
+	tf.RunTest("Long flag - documents wiring flags too",
+		strings.Contains(stdout, "--buffered") && strings.Contains(stdout, "--output"))
+
+	// ========================================================================
+	// Test: The -h short form behaves identically
+	// ========================================================================
+
+	stdout, _, code = cli.RunInProcess([]string{"-h"})
+	tf.RunTest("Short flag - exit code 0", code == 0)
+	tf.RunTest("Short flag - usage on stdout", strings.Contains(stdout, "Usage:"))
+
+	// ========================================================================
+	// Test: Errors keep the short usage on stderr with exit 1
+	// ========================================================================
+
+	stdout, stderr, code = cli.RunInProcess([]string{"--no-such-flag", "Alice"})
+	tf.RunTest("Unknown flag - exit code 1", code == 1)
+	tf.RunTest("Unknown flag - nothing on stdout", stdout == "")
+	tf.RunTest("Unknown flag - short usage on stderr",
+		strings.Contains(stderr, "Usage: greeter"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliUppercaseFlag tests shouted greetings end to end.
+func TestBootstrapCliUppercaseFlag(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.UppercaseFlag")
+
+	// ========================================================================
+	// Test: --uppercase shouts the greeting
+	// ========================================================================
+
+	stdout, _, code := cli.RunInProcess([]string{"--uppercase", "Alice"})
+	tf.RunTest("Uppercase - exit code 0", code == 0)
+	tf.RunTest("Uppercase - greeting shouted", stdout == "HELLO, ALICE!\n")
+
+	// ========================================================================
+	// Test: The --upper spelling and combinations work too
+	// ========================================================================
+
+	stdout, _, code = cli.RunInProcess([]string{"--upper", "--lang=fr", "Alice"})
+	tf.RunTest("Combined - exit code 0", code == 0)
+	tf.RunTest("Combined - localized greeting shouted",
+		stdout == "BONJOUR, ALICE !\n")
+
+	// ========================================================================
+	// Test: Without the flag the greeting keeps its case
+	// ========================================================================
+
+	stdout, _, code = cli.RunInProcess([]string{"Alice"})
+	tf.RunTest("No flag - exit code 0", code == 0)
+	tf.RunTest("No flag - greeting unchanged", stdout == "Hello, Alice!\n")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestBootstrapCliQuietFlag tests the exit-code-only mode.
+func TestBootstrapCliQuietFlag(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.QuietFlag")
+
+	// ========================================================================
+	// Test: A valid name exits 0 with no output at all
+	// ========================================================================
+
+	stdout, stderr, code := cli.RunInProcess([]string{"--quiet", "Alice"})
+	tf.RunTest("Valid - exit code 0", code == 0)
+	tf.RunTest("Valid - stdout empty", stdout == "")
+	tf.RunTest("Valid - stderr empty", stderr == "")
+
+	// ========================================================================
+	// Test: An invalid name exits 1, still silently
+	// ========================================================================
+
+	longName := strings.Repeat("x", 101)
+	stdout, stderr, code = cli.RunInProcess([]string{"--quiet", longName})
+	tf.RunTest("Invalid - exit code 1", code == 1)
+	tf.RunTest("Invalid - stdout empty", stdout == "")
+	tf.RunTest("Invalid - stderr empty", stderr == "")
+
+	// ========================================================================
+	// Test: Argument errors are silent too
+	// ========================================================================
+
+	stdout, stderr, code = cli.RunInProcess([]string{"--quiet"})
+	tf.RunTest("No name - exit code 1", code == 1)
+	tf.RunTest("No name - no usage printed", stdout == "" && stderr == "")
+
+	// ========================================================================
+	// Test: A mixed batch reports the worst outcome, silently
+	// ========================================================================
+
+	stdout, stderr, code = cli.RunInProcess([]string{"--quiet", "Alice", longName, "Bob"})
+	tf.RunTest("Mixed batch - exit code 1", code == 1)
+	tf.RunTest("Mixed batch - no greetings and no summary",
+		stdout == "" && stderr == "")
+
+	// ========================================================================
+	// Test: Without --quiet the same inputs still produce output
+	// ========================================================================
+
+	stdout, _, code = cli.RunInProcess([]string{"Alice"})
+	tf.RunTest("Control - greeting printed without the flag",
+		code == 0 && stdout == "Hello, Alice!\n")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// timeoutWriter always fails with a TimeoutError, standing in for a sink
+// whose writes are aborted by an expiring context.
+type timeoutWriter struct{}
+
+func (timeoutWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](domerr.NewTimeoutError("write cancelled: deadline exceeded"))
+}
+
+// TestBootstrapCliBatchTimeoutKind tests that batch mode surfaces error
+// kinds beyond the two enumerated in the summary counts.
+func TestBootstrapCliBatchTimeoutKind(t *testing.T) {
+	tf := test.New("Bootstrap.Cli.BatchTimeoutKind")
+
+	adapter.RegisterWriterFactory("timeout-test", func(cfg map[string]string) domerr.Result[outbound.WriterPort] {
+		return domerr.Ok[outbound.WriterPort](timeoutWriter{})
+	})
+
+	// ========================================================================
+	// Test: A batch of timed-out writes exits 2, not 0
+	// ========================================================================
+
+	stdout, stderr, code := cli.RunInProcess([]string{"--output=timeout-test", "Alice", "Bob"})
+	tf.RunTest("Timeout batch - exit code 2", code == 2)
+	tf.RunTest("Timeout batch - nothing on stdout", stdout == "")
+	tf.RunTest("Timeout batch - failures counted in the summary",
+		strings.Contains(stderr, "succeeded: 0, validation errors: 0, infrastructure errors: 0, other errors: 2"))
+
+	// ========================================================================
+	// Test: Mixed kinds - a timeout still dominates a validation failure
+	// ========================================================================
+
+	longName := strings.Repeat("x", 101)
+	_, stderr, code = cli.RunInProcess([]string{"--output=timeout-test", "Alice", longName})
+	tf.RunTest("Mixed kinds - worst exit code wins", code == 2)
+	tf.RunTest("Mixed kinds - both failures counted",
+		strings.Contains(stderr, "validation errors: 1") &&
+			strings.Contains(stderr, "other errors: 1"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
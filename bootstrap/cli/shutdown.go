@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: cli
+// Description: Graceful-shutdown plumbing for stateful writers
+
+package cli
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Flusher is implemented by stateful writers whose buffered output must be
+// pushed downstream before shutdown (e.g. BufferedWriter).
+type Flusher interface {
+	Flush() domerr.Result[model.Unit]
+}
+
+// Closer is implemented by writers holding resources that must be released
+// on shutdown (e.g. AsyncTeeWriter, GzipWriter).
+type Closer interface {
+	Close() domerr.Result[model.Unit]
+}
+
+// NotifyShutdown derives a context that is cancelled on SIGTERM or SIGINT,
+// so an interrupted run unwinds through the normal cancellation path
+// instead of dying mid-write. The returned stop function releases the
+// signal registration; call it once the run completes.
+func NotifyShutdown(ctx context.Context) (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+}
+
+// FlushOnShutdown watches ctx and, when it is cancelled - by a signal via
+// NotifyShutdown or by any other cancel - flushes and then closes writer,
+// whichever of the two it implements. The returned channel is closed once
+// the writer has been settled, so callers can block on it before exiting:
+//
+//	ctx, stop := cli.NotifyShutdown(ctx)
+//	defer stop()
+//	settled := cli.FlushOnShutdown(ctx, bufferedWriter)
+//	// ... long-running work ...
+//	<-settled
+//
+// Writers implementing neither interface are settled immediately on
+// cancellation; flush or close failures are deliberately swallowed here,
+// as there is no one left to report them to during shutdown.
+func FlushOnShutdown(ctx context.Context, writer any) <-chan struct{} {
+	settled := make(chan struct{})
+	go func() {
+		defer close(settled)
+		<-ctx.Done()
+		if f, ok := writer.(Flusher); ok {
+			f.Flush()
+		}
+		if c, ok := writer.(Closer); ok {
+			c.Close()
+		}
+	}()
+	return settled
+}
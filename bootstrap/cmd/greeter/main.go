@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: main
+// Description: Entry point for the greeter CLI executable
+
+// The greeter command greets a person by name on the console.
+//
+// Usage:
+//
+//	greeter [options] <name>
+//
+// All wiring lives in bootstrap/cli; main only binds the process streams
+// and exit code.
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/abitofhelp/hybrid_lib_go/bootstrap/cli"
+)
+
+func main() {
+	os.Exit(cli.Run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+module github.com/abitofhelp/hybrid_lib_go/bootstrap
+
+go 1.23
+
+// Bootstrap layer - Composition root for the CLI executable
+// Wires Infrastructure adapters into Application use cases and hands
+// control to the Presentation layer
+
+require (
+	github.com/abitofhelp/hybrid_lib_go v0.0.0
+	github.com/abitofhelp/hybrid_lib_go/application v0.0.0
+	github.com/abitofhelp/hybrid_lib_go/domain v0.0.0
+	github.com/abitofhelp/hybrid_lib_go/infrastructure v0.0.0
+	github.com/abitofhelp/hybrid_lib_go/presentation v0.0.0
+)
+
+replace (
+	github.com/abitofhelp/hybrid_lib_go => ../
+	github.com/abitofhelp/hybrid_lib_go/application => ../application
+	github.com/abitofhelp/hybrid_lib_go/domain => ../domain
+	github.com/abitofhelp/hybrid_lib_go/infrastructure => ../infrastructure
+	github.com/abitofhelp/hybrid_lib_go/presentation => ../presentation
+)
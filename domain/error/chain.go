@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: error
+// Description: Fluent chaining wrapper over Result
+
+package error
+
+// Chain is a fluent wrapper over a Result for same-type pipelines.
+//
+// Go methods cannot introduce new type parameters, so type-changing
+// transforms on Result must be free functions (MapTo, AndThenTo), which
+// breaks the left-to-right reading of a pipeline. Chain restores fluency
+// for the same-type steps:
+//
+//	r := domerr.Start(CreatePerson(name)).
+//	    Map(decorate).
+//	    Filter(isShort, tooLong).
+//	    Done()
+//
+// Every method simply delegates to the Result combinator of the same
+// name, so Chain adds vocabulary, not semantics. For a step that changes
+// the value type, leave the chain with ChainTo and keep going:
+//
+//	lengths := domerr.ChainTo(chain, func(p Person) int { return len(p.GetName()) })
+type Chain[T any] struct {
+	result Result[T]
+}
+
+// Start begins a fluent chain from an existing Result.
+func Start[T any](r Result[T]) Chain[T] {
+	return Chain[T]{result: r}
+}
+
+// Map applies f to the success value, propagating errors unchanged.
+// Delegates to Result.Map.
+func (c Chain[T]) Map(f func(T) T) Chain[T] {
+	return Chain[T]{result: c.result.Map(f)}
+}
+
+// Filter rejects an Ok value failing pred with onFail.
+// Delegates to Result.Filter.
+func (c Chain[T]) Filter(pred func(T) bool, onFail ErrorType) Chain[T] {
+	return Chain[T]{result: c.result.Filter(pred, onFail)}
+}
+
+// AndThen chains a same-type fallible step (monadic bind).
+// Delegates to Result.AndThen.
+func (c Chain[T]) AndThen(f func(T) Result[T]) Chain[T] {
+	return Chain[T]{result: c.result.AndThen(f)}
+}
+
+// MapError transforms the error, leaving Ok values untouched.
+// Delegates to Result.MapError.
+func (c Chain[T]) MapError(f func(ErrorType) ErrorType) Chain[T] {
+	return Chain[T]{result: c.result.MapError(f)}
+}
+
+// Done terminates the chain, yielding the underlying Result.
+func (c Chain[T]) Done() Result[T] {
+	return c.result
+}
+
+// ChainTo applies a type-changing transform to the chain's value and
+// starts a new chain of the target type; the free-function escape hatch
+// for the one thing methods cannot express. Delegates to MapTo.
+func ChainTo[T any, U any](c Chain[T], f func(T) U) Chain[U] {
+	return Chain[U]{result: MapTo(c.result, f)}
+}
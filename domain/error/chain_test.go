@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorChain tests the fluent pipeline wrapper.
+func TestDomainErrorChain(t *testing.T) {
+	tf := test.New("Domain.Error.Chain")
+
+	double := func(n int) int { return n * 2 }
+	positive := func(n int) bool { return n > 0 }
+	notPositive := domerr.NewValidationError("value must be positive")
+
+	// ========================================================================
+	// Test: A multi-step fluent pipeline matches the free-function form
+	// ========================================================================
+
+	fluent := domerr.Start(domerr.Ok(21)).
+		Map(double).
+		Filter(positive, notPositive).
+		Done()
+	free := domerr.Ok(21).Map(double).Filter(positive, notPositive)
+	tf.RunTest("Pipeline - Ok with transformed value",
+		fluent.IsOk() && fluent.Value() == 42)
+	tf.RunTest("Pipeline - equivalent to free-function form",
+		domerr.Equal(fluent, free))
+
+	// ========================================================================
+	// Test: Errors propagate through every step untouched
+	// ========================================================================
+
+	failed := domerr.Start(domerr.Err[int](domerr.NewInfrastructureError("boom"))).
+		Map(double).
+		Filter(positive, notPositive).
+		Done()
+	tf.RunTest("Err start - error propagates",
+		failed.IsError() && failed.ErrorInfo().Message == "boom")
+
+	// ========================================================================
+	// Test: Filter rejects mid-chain and later steps are inert
+	// ========================================================================
+
+	rejected := domerr.Start(domerr.Ok(-3)).
+		Filter(positive, notPositive).
+		Map(double).
+		Done()
+	tf.RunTest("Filter - rejection error surfaces",
+		rejected.IsError() && rejected.ErrorInfo() == notPositive)
+
+	// ========================================================================
+	// Test: AndThen and MapError participate in the chain
+	// ========================================================================
+
+	parsed := domerr.Start(domerr.Ok(7)).
+		AndThen(func(n int) domerr.Result[int] { return domerr.Ok(n + 1) }).
+		Done()
+	tf.RunTest("AndThen - bind applied", parsed.IsOk() && parsed.Value() == 8)
+
+	renamed := domerr.Start(domerr.Err[int](domerr.NewValidationError("raw"))).
+		MapError(func(e domerr.ErrorType) domerr.ErrorType {
+			return e.WithMessage("context: " + e.Message)
+		}).
+		Done()
+	tf.RunTest("MapError - error transformed",
+		renamed.IsError() && strings.HasPrefix(renamed.ErrorInfo().Message, "context:"))
+
+	// ========================================================================
+	// Test: ChainTo changes the value type and chaining continues
+	// ========================================================================
+
+	asString := domerr.ChainTo(
+		domerr.Start(domerr.Ok(42)).Map(double),
+		strconv.Itoa).
+		Map(func(s string) string { return s + "!" }).
+		Done()
+	tf.RunTest("ChainTo - type change mid-pipeline",
+		asString.IsOk() && asString.Value() == "84!")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -28,7 +28,10 @@
 //	}
 package error
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // ErrorKind represents categories of errors that can occur in the application.
 // This enables pattern matching and different handling strategies per category.
@@ -45,6 +48,12 @@ const (
 
 	// InfrastructureError indicates infrastructure failures (I/O, network, DB)
 	InfrastructureError
+
+	// NotFoundError indicates a lookup that missed (entity does not exist)
+	NotFoundError
+
+	// TimeoutError indicates an operation aborted by cancellation or deadline
+	TimeoutError
 )
 
 // String returns a human-readable representation of the ErrorKind.
@@ -54,6 +63,10 @@ func (k ErrorKind) String() string {
 		return "ValidationError"
 	case InfrastructureError:
 		return "InfrastructureError"
+	case NotFoundError:
+		return "NotFoundError"
+	case TimeoutError:
+		return "TimeoutError"
 	default:
 		return "UnknownError"
 	}
@@ -73,12 +86,175 @@ func (k ErrorKind) String() string {
 type ErrorType struct {
 	Kind    ErrorKind
 	Message string
+
+	// Code is an optional stable machine-readable identifier, independent
+	// of the human-oriented Message. Empty for errors created by the plain
+	// constructors; set via the WithCode constructor variants.
+	Code string
+
+	// fields holds optional structured metadata (see WithField/Field).
+	// It is a pointer so ErrorType stays comparable with ==: errors
+	// without fields compare by Kind and Message exactly as before,
+	// and a propagating error keeps its identity through the railway.
+	fields *errorFields
+
+	// cause is the wrapped underlying error, if any (see Wrap/Unwrap).
+	// A pointer for the same comparability reason as fields.
+	cause *ErrorType
+}
+
+// errorFields is the immutable backing store for an ErrorType's structured
+// metadata. WithField copies on write, so shared errors are never mutated.
+type errorFields struct {
+	entries map[string]string
+}
+
+// WithField returns a copy of the error carrying the given key/value in its
+// structured metadata. The receiver is unchanged (copy-on-write), so errors
+// can be enriched as they propagate without aliasing surprises.
+//
+// Example:
+//
+//	err := NewInfrastructureError("write failed").WithField("duration_ms", "123")
+func (e ErrorType) WithField(key, value string) ErrorType {
+	entries := make(map[string]string)
+	if e.fields != nil {
+		for k, v := range e.fields.entries {
+			entries[k] = v
+		}
+	}
+	entries[key] = value
+	e.fields = &errorFields{entries: entries}
+	return e
+}
+
+// Field returns the metadata value for key and whether it is present.
+func (e ErrorType) Field(key string) (string, bool) {
+	if e.fields == nil {
+		return "", false
+	}
+	v, ok := e.fields.entries[key]
+	return v, ok
+}
+
+// Fields returns a copy of the error's structured metadata. The result is
+// never nil; errors without metadata yield an empty map.
+func (e ErrorType) Fields() map[string]string {
+	entries := make(map[string]string)
+	if e.fields != nil {
+		for k, v := range e.fields.entries {
+			entries[k] = v
+		}
+	}
+	return entries
+}
+
+// Wrap returns a copy of the error recording cause as its underlying error,
+// so context can be layered on without discarding the root failure:
+//
+//	err := NewInfrastructureError("greeting not delivered").Wrap(writeErr)
+//
+// The receiver is unchanged. The wrapped cause is visible via Unwrap and
+// therefore to errors.Is/errors.As chains, and Error() renders both layers.
+func (e ErrorType) Wrap(cause ErrorType) ErrorType {
+	e.cause = &cause
+	return e
+}
+
+// Unwrap returns the wrapped cause as a standard error, or nil when the
+// error has no cause. This makes ErrorType participate in errors.Is and
+// errors.As chain walking.
+func (e ErrorType) Unwrap() error {
+	if e.cause == nil {
+		return nil
+	}
+	return *e.cause
+}
+
+// WithMessage returns a copy of the error with the message replaced,
+// keeping the Kind, any cause, and any structured metadata. The receiver
+// is unchanged.
+func (e ErrorType) WithMessage(msg string) ErrorType {
+	e.Message = msg
+	return e
+}
+
+// WithContext returns a copy of the error with "prefix: " prepended to the
+// existing message, for layering context as an error propagates without
+// constructing a fresh ErrorType literal inside MapError:
+//
+//	result.MapError(func(e ErrorType) ErrorType {
+//	    return e.WithContext("loading config")
+//	})
+func (e ErrorType) WithContext(prefix string) ErrorType {
+	e.Message = prefix + ": " + e.Message
+	return e
 }
 
 // Error implements the error interface for ErrorType.
 // This allows ErrorType to be used as a standard Go error when needed.
+// When a cause is present the chain renders as "Kind: message: <cause>".
+// A non-empty Code is appended as " [code]".
 func (e ErrorType) Error() string {
-	return fmt.Sprintf("%s: %s", e.Kind, e.Message)
+	rendered := fmt.Sprintf("%s: %s", e.Kind, e.Message)
+	if e.cause != nil {
+		rendered = fmt.Sprintf("%s: %s", rendered, e.cause.Error())
+	}
+	if e.Code != "" {
+		rendered += " [" + e.Code + "]"
+	}
+	return rendered
+}
+
+// errorTypeJSON is the stable wire shape for ErrorType: the Kind travels as
+// its String() form so the iota ordering never leaks into serialized output.
+type errorTypeJSON struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	Code    string `json:"code,omitempty"`
+}
+
+// kindFromString parses the String() form of an ErrorKind back to the enum.
+func kindFromString(s string) (ErrorKind, bool) {
+	switch s {
+	case "ValidationError":
+		return ValidationError, true
+	case "InfrastructureError":
+		return InfrastructureError, true
+	case "NotFoundError":
+		return NotFoundError, true
+	case "TimeoutError":
+		return TimeoutError, true
+	default:
+		return 0, false
+	}
+}
+
+// MarshalJSON renders the error as {"kind":"ValidationError","message":"..."}
+// (plus "code" when set), keeping serialized output stable across reorderings
+// of the ErrorKind constants.
+func (e ErrorType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorTypeJSON{
+		Kind:    e.Kind.String(),
+		Message: e.Message,
+		Code:    e.Code,
+	})
+}
+
+// UnmarshalJSON parses the wire shape produced by MarshalJSON, rejecting
+// unknown kind strings so corrupted or future payloads fail loudly rather
+// than silently mapping to kind zero.
+func (e *ErrorType) UnmarshalJSON(data []byte) error {
+	var wire errorTypeJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	kind, ok := kindFromString(wire.Kind)
+	if !ok {
+		return fmt.Errorf("unknown error kind %q", wire.Kind)
+	}
+	*e = ErrorType{Kind: kind, Message: wire.Message, Code: wire.Code}
+	return nil
 }
 
 // NewValidationError creates a new validation error with the given message.
@@ -96,3 +272,43 @@ func NewInfrastructureError(message string) ErrorType {
 		Message: message,
 	}
 }
+
+// NewNotFoundError creates a new not-found error with the given message.
+func NewNotFoundError(message string) ErrorType {
+	return ErrorType{
+		Kind:    NotFoundError,
+		Message: message,
+	}
+}
+
+// NewTimeoutError creates a new timeout error with the given message.
+func NewTimeoutError(message string) ErrorType {
+	return ErrorType{
+		Kind:    TimeoutError,
+		Message: message,
+	}
+}
+
+// NewValidationErrorWithCode creates a validation error carrying a stable
+// machine-readable code alongside the human message.
+func NewValidationErrorWithCode(code, message string) ErrorType {
+	return ErrorType{Kind: ValidationError, Code: code, Message: message}
+}
+
+// NewInfrastructureErrorWithCode creates an infrastructure error carrying a
+// stable machine-readable code alongside the human message.
+func NewInfrastructureErrorWithCode(code, message string) ErrorType {
+	return ErrorType{Kind: InfrastructureError, Code: code, Message: message}
+}
+
+// NewNotFoundErrorWithCode creates a not-found error carrying a stable
+// machine-readable code alongside the human message.
+func NewNotFoundErrorWithCode(code, message string) ErrorType {
+	return ErrorType{Kind: NotFoundError, Code: code, Message: message}
+}
+
+// NewTimeoutErrorWithCode creates a timeout error carrying a stable
+// machine-readable code alongside the human message.
+func NewTimeoutErrorWithCode(code, message string) ErrorType {
+	return ErrorType{Kind: TimeoutError, Code: code, Message: message}
+}
@@ -0,0 +1,58 @@
+// ===========================================================================
+// foldchannel.go
+// ===========================================================================
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// SPDX-License-Identifier: BSD-3-Clause
+//
+// Purpose:
+//   Streaming left-fold over a channel of Results, for aggregating totals
+//   from producers without collecting everything into a slice first.
+//
+// Architecture Notes:
+//   - Generic over element type T and accumulator type A
+//   - Pure domain implementation (stdlib context only)
+//   - Short-circuits on the first Err or on context cancellation
+// ===========================================================================
+
+package error
+
+import (
+	"context"
+	"fmt"
+)
+
+// FoldChannel folds f over the Ok values received from in, starting from
+// initial, until the channel closes.
+//
+// Short-circuit behavior:
+//   - The first Err received is returned immediately (remaining channel
+//     items are left unread; the producer owns channel cleanup)
+//   - If ctx is cancelled, Err(InfrastructureError) with the context's
+//     error is returned immediately
+//
+// Example:
+//
+//	total := FoldChannel(ctx, results, 0, func(acc int, n int) int { return acc + n })
+//
+// Contract:
+//   - Post: Returns Ok(final accumulator) when in closes without errors
+//   - Post: Returns the first Err unchanged if one arrives
+//   - Post: Returns Err(InfrastructureError) on cancellation
+func FoldChannel[T any, A any](ctx context.Context, in <-chan Result[T], initial A, f func(A, T) A) Result[A] {
+	acc := initial
+	for {
+		select {
+		case <-ctx.Done():
+			return Err[A](NewInfrastructureError(
+				fmt.Sprintf("fold cancelled: %v", ctx.Err())))
+		case r, open := <-in:
+			if !open {
+				return Ok(acc)
+			}
+			if r.IsError() {
+				return Err[A](r.ErrorInfo())
+			}
+			acc = f(acc, r.Value())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"context"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorFoldChannel tests the FoldChannel streaming fold.
+func TestDomainErrorFoldChannel(t *testing.T) {
+	tf := test.New("Domain.Error.FoldChannel")
+	ctx := context.Background()
+
+	sum := func(acc int, n int) int { return acc + n }
+
+	// ========================================================================
+	// Test: All-ok stream folds to the expected total
+	// ========================================================================
+
+	in1 := make(chan domerr.Result[int], 4)
+	for _, n := range []int{1, 2, 3, 4} {
+		in1 <- domerr.Ok(n)
+	}
+	close(in1)
+
+	r1 := domerr.FoldChannel(ctx, in1, 0, sum)
+	tf.RunTest("All-ok stream - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("All-ok stream - correct fold total", r1.Value() == 10)
+	}
+
+	// ========================================================================
+	// Test: Empty stream returns the initial accumulator
+	// ========================================================================
+
+	in2 := make(chan domerr.Result[int])
+	close(in2)
+	r2 := domerr.FoldChannel(ctx, in2, 100, sum)
+	tf.RunTest("Empty stream - IsOk returns true", r2.IsOk())
+	if r2.IsOk() {
+		tf.RunTest("Empty stream - initial returned", r2.Value() == 100)
+	}
+
+	// ========================================================================
+	// Test: Error mid-stream short-circuits with that error
+	// ========================================================================
+
+	in3 := make(chan domerr.Result[int], 4)
+	in3 <- domerr.Ok(1)
+	in3 <- domerr.Err[int](domerr.NewValidationError("bad element"))
+	in3 <- domerr.Ok(2)
+	close(in3)
+
+	r3 := domerr.FoldChannel(ctx, in3, 0, sum)
+	tf.RunTest("Mid-stream error - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		info := r3.ErrorInfo()
+		tf.RunTest("Mid-stream error - original error preserved",
+			info.Kind == domerr.ValidationError && info.Message == "bad element")
+	}
+
+	// ========================================================================
+	// Test: Cancelled context short-circuits with InfrastructureError
+	// ========================================================================
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	in4 := make(chan domerr.Result[int]) // never written: fold must not block
+
+	r4 := domerr.FoldChannel(cancelledCtx, in4, 0, sum)
+	tf.RunTest("Cancelled context - IsError returns true", r4.IsError())
+	if r4.IsError() {
+		tf.RunTest("Cancelled context - kind is InfrastructureError",
+			r4.ErrorInfo().Kind == domerr.InfrastructureError)
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,62 @@
+// ===========================================================================
+// memoize.go
+// ===========================================================================
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// SPDX-License-Identifier: BSD-3-Clause
+//
+// Purpose:
+//   Memoization for Result-producing functions. Caches successful Results
+//   by key so expensive validations run at most once per input, while
+//   errors stay uncached and can be retried.
+//
+// Architecture Notes:
+//   - Generic over key type K (comparable) and value type V
+//   - Pure domain implementation (stdlib sync only)
+//   - Concurrency-safe via a mutex-guarded map
+// ===========================================================================
+
+package error
+
+import "sync"
+
+// Memoize wraps a Result-producing function with a concurrency-safe cache.
+//
+// Caching policy:
+//   - Ok Results are cached by key; subsequent calls return the cached
+//     Result without invoking f again
+//   - Err Results are NOT cached, so transient failures can be retried
+//
+// The returned function is safe for concurrent use. Note that under
+// concurrent first calls for the same key, f may run more than once; the
+// guarantee is "at most one stored success", not "exactly one invocation".
+//
+// Example:
+//
+//	cachedParse := Memoize(parseConfig)
+//	r1 := cachedParse("app.toml") // runs parseConfig
+//	r2 := cachedParse("app.toml") // served from cache if r1 was Ok
+func Memoize[K comparable, V any](f func(K) Result[V]) func(K) Result[V] {
+	var (
+		mu    sync.Mutex
+		cache = make(map[K]Result[V])
+	)
+
+	return func(key K) Result[V] {
+		mu.Lock()
+		if cached, hit := cache[key]; hit {
+			mu.Unlock()
+			return cached
+		}
+		mu.Unlock()
+
+		// Compute outside the lock so slow f calls don't serialize all keys
+		result := f(key)
+
+		if result.IsOk() {
+			mu.Lock()
+			cache[key] = result
+			mu.Unlock()
+		}
+		return result
+	}
+}
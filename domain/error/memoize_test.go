@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorMemoize tests the Memoize wrapper.
+func TestDomainErrorMemoize(t *testing.T) {
+	tf := test.New("Domain.Error.Memoize")
+
+	// ========================================================================
+	// Test: Successful Results are cached (f runs once per key)
+	// ========================================================================
+
+	var calls atomic.Int64
+	double := domerr.Memoize(func(x int) domerr.Result[int] {
+		calls.Add(1)
+		return domerr.Ok(x * 2)
+	})
+
+	r1 := double(21)
+	r2 := double(21)
+	tf.RunTest("Memoize success - first call Ok", r1.IsOk() && r1.Value() == 42)
+	tf.RunTest("Memoize success - second call Ok", r2.IsOk() && r2.Value() == 42)
+	tf.RunTest("Memoize success - f ran once for repeated key", calls.Load() == 1)
+
+	double(5)
+	tf.RunTest("Memoize success - distinct key runs f again", calls.Load() == 2)
+
+	// ========================================================================
+	// Test: Errors are not cached (f re-runs until success)
+	// ========================================================================
+
+	var flakyCalls atomic.Int64
+	flaky := domerr.Memoize(func(x int) domerr.Result[int] {
+		n := flakyCalls.Add(1)
+		if n < 3 {
+			return domerr.Err[int](domerr.NewInfrastructureError("transient"))
+		}
+		return domerr.Ok(x)
+	})
+
+	e1 := flaky(7)
+	e2 := flaky(7)
+	ok := flaky(7)
+	cached := flaky(7)
+	tf.RunTest("Memoize error - first call is Err", e1.IsError())
+	tf.RunTest("Memoize error - error was retried", e2.IsError())
+	tf.RunTest("Memoize error - third call succeeds", ok.IsOk() && ok.Value() == 7)
+	tf.RunTest("Memoize error - success now cached",
+		cached.IsOk() && flakyCalls.Load() == 3)
+
+	// ========================================================================
+	// Test: Concurrent access is safe (run with -race)
+	// ========================================================================
+
+	var concCalls atomic.Int64
+	concurrent := domerr.Memoize(func(x int) domerr.Result[int] {
+		concCalls.Add(1)
+		return domerr.Ok(x * x)
+	})
+
+	var wg sync.WaitGroup
+	var failures atomic.Int64
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r := concurrent(i % 5)
+			if r.IsError() || r.Value() != (i%5)*(i%5) {
+				failures.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	tf.RunTest("Memoize concurrent - all results correct", failures.Load() == 0)
+	tf.RunTest("Memoize concurrent - calls bounded by goroutine count",
+		concCalls.Load() <= 50)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
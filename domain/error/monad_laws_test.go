@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"strconv"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorResultMonadLaws verifies that Result[T] obeys the three
+// monad laws with AndThenTo as bind and Ok as return:
+//
+//  1. Left identity:  Ok(a) >>= f      ==  f(a)
+//  2. Right identity: m >>= Ok         ==  m
+//  3. Associativity:  (m >>= f) >>= g  ==  m >>= (x -> f(x) >>= g)
+//
+// The laws are checked for representative functions and values on both the
+// Ok and Err tracks, guarding the combinators against regressions.
+func TestDomainErrorResultMonadLaws(t *testing.T) {
+	tf := test.New("Domain.Error.Result.MonadLaws")
+
+	// Representative Kleisli arrows for the laws
+	double := func(x int) domerr.Result[int] {
+		return domerr.Ok(x * 2)
+	}
+	toString := func(x int) domerr.Result[string] {
+		return domerr.Ok(strconv.Itoa(x))
+	}
+	failing := func(x int) domerr.Result[int] {
+		return domerr.Err[int](domerr.NewValidationError("rejected"))
+	}
+
+	// ========================================================================
+	// Law 1: Left identity - Ok(a).AndThenTo(f) == f(a)
+	// ========================================================================
+
+	a := 21
+	left1 := domerr.AndThenTo(domerr.Ok(a), double)
+	right1 := double(a)
+	tf.RunTest("Left identity - succeeding f", left1 == right1)
+
+	left2 := domerr.AndThenTo(domerr.Ok(a), failing)
+	right2 := failing(a)
+	tf.RunTest("Left identity - failing f", left2 == right2)
+
+	left3 := domerr.AndThenTo(domerr.Ok(a), toString)
+	right3 := toString(a)
+	tf.RunTest("Left identity - type-changing f", left3 == right3)
+
+	// ========================================================================
+	// Law 2: Right identity - m.AndThenTo(Ok) == m
+	// ========================================================================
+
+	mOk := domerr.Ok(42)
+	tf.RunTest("Right identity - Ok input",
+		domerr.AndThenTo(mOk, domerr.Ok[int]) == mOk)
+
+	mErr := domerr.Err[int](domerr.NewInfrastructureError("boom"))
+	tf.RunTest("Right identity - Err input",
+		domerr.AndThenTo(mErr, domerr.Ok[int]) == mErr)
+
+	// ========================================================================
+	// Law 3: Associativity -
+	//   (m >>= f) >>= g  ==  m >>= (x -> f(x) >>= g)
+	// ========================================================================
+
+	assoc := func(name string, m domerr.Result[int],
+		f func(int) domerr.Result[int], g func(int) domerr.Result[string]) {
+		lhs := domerr.AndThenTo(domerr.AndThenTo(m, f), g)
+		rhs := domerr.AndThenTo(m, func(x int) domerr.Result[string] {
+			return domerr.AndThenTo(f(x), g)
+		})
+		tf.RunTest("Associativity - "+name, lhs == rhs)
+	}
+
+	assoc("Ok input, both succeed", domerr.Ok(5), double, toString)
+	assoc("Ok input, first fails", domerr.Ok(5), failing, toString)
+	assoc("Err input short-circuits", mErr, double, toString)
+
+	// The method form AndThen (same-type bind) must agree with AndThenTo
+	sameLHS := domerr.Ok(7).AndThen(double).AndThen(double)
+	sameRHS := domerr.AndThenTo(domerr.AndThenTo(domerr.Ok(7), double), double)
+	tf.RunTest("Method AndThen agrees with free AndThenTo", sameLHS == sameRHS)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
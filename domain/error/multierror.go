@@ -0,0 +1,84 @@
+// ===========================================================================
+// multierror.go
+// ===========================================================================
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// SPDX-License-Identifier: BSD-3-Clause
+//
+// Purpose:
+//   Accumulate several ErrorTypes and collapse them into one, for
+//   accumulate-all-errors validation and aggregated health reporting -
+//   cases where short-circuiting on the first error would hide the rest.
+//
+// Design Notes:
+//   - Pure domain implementation (standard library only)
+//   - The zero value is an empty, ready-to-use accumulator
+//   - Collapses to a single ErrorType so aggregated outcomes flow through
+//     the existing Result railway unchanged
+// ===========================================================================
+
+package error
+
+import "strings"
+
+// MultiError accumulates ErrorTypes for later collapse into a single
+// ErrorType. Unlike the Result railway, which short-circuits on the first
+// error, a MultiError gathers every failure so callers can report them all.
+//
+// The zero value is an empty accumulator ready for use. Not safe for
+// concurrent use; aggregate per goroutine and merge.
+//
+// Usage:
+//
+//	var multi MultiError
+//	multi.Append(NewValidationError("name is empty"))
+//	multi.Append(NewValidationError("email is malformed"))
+//	if multi.HasErrors() {
+//	    return Err[Contact](multi.ToErrorType(ValidationError))
+//	}
+type MultiError struct {
+	errors []ErrorType
+}
+
+// NewMultiError creates a MultiError seeded with the given errors.
+func NewMultiError(errors ...ErrorType) *MultiError {
+	return &MultiError{errors: append([]ErrorType(nil), errors...)}
+}
+
+// Append adds the given errors to the accumulator.
+func (m *MultiError) Append(errors ...ErrorType) {
+	m.errors = append(m.errors, errors...)
+}
+
+// HasErrors reports whether any errors have been accumulated.
+func (m *MultiError) HasErrors() bool {
+	return len(m.errors) > 0
+}
+
+// Len returns the number of accumulated errors.
+func (m *MultiError) Len() int {
+	return len(m.errors)
+}
+
+// Errors returns a copy of the accumulated errors in insertion order.
+func (m *MultiError) Errors() []ErrorType {
+	return append([]ErrorType(nil), m.errors...)
+}
+
+// ToErrorType collapses the accumulated errors into a single ErrorType of
+// the given kind. A single accumulated error keeps its message verbatim;
+// several are joined with "; ". An empty accumulator yields an ErrorType
+// with an explanatory message - check HasErrors first in normal flow.
+func (m *MultiError) ToErrorType(kind ErrorKind) ErrorType {
+	switch len(m.errors) {
+	case 0:
+		return ErrorType{Kind: kind, Message: "no errors accumulated"}
+	case 1:
+		return ErrorType{Kind: kind, Message: m.errors[0].Message}
+	default:
+		messages := make([]string, len(m.errors))
+		for i, e := range m.errors {
+			messages[i] = e.Message
+		}
+		return ErrorType{Kind: kind, Message: strings.Join(messages, "; ")}
+	}
+}
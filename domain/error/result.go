@@ -205,6 +205,20 @@ func (r Result[T]) Map(f func(T) T) Result[T] {
 	return r
 }
 
+// MapIf applies f only when the Result is Ok AND cond is true, otherwise
+// returns the Result unchanged. This avoids branching at the call site for
+// conditional transforms driven by a cheap flag.
+//
+// Example:
+//
+//	greeting := result.MapIf(uppercase, strings.ToUpper)
+func (r Result[T]) MapIf(cond bool, f func(T) T) Result[T] {
+	if r.isOk && cond {
+		return Ok(f(r.value))
+	}
+	return r
+}
+
 // MapTo transforms the success value to a different type U if Ok, propagates error if Error.
 //
 // Example:
@@ -243,6 +257,65 @@ func AndThenTo[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
 	return Err[U](r.err)
 }
 
+// Filter turns an Ok value that fails a business rule into an Err without
+// leaving railway style. If Ok and pred holds, the Result is unchanged; if
+// Ok and pred fails, returns Err(onFail); an existing Err propagates.
+//
+// Example:
+//
+//	adult := ageResult.Filter(func(a int) bool { return a >= 18 },
+//	    NewValidationError("must be an adult"))
+func (r Result[T]) Filter(pred func(T) bool, onFail ErrorType) Result[T] {
+	if r.isOk && !pred(r.value) {
+		return Err[T](onFail)
+	}
+	return r
+}
+
+// FilterWith is the lazy form of Filter: the error is built from the
+// rejected value, so diagnostics can say what was rejected and why.
+//
+// Example:
+//
+//	adult := ageResult.FilterWith(func(a int) bool { return a >= 18 },
+//	    func(a int) ErrorType {
+//	        return NewValidationError(fmt.Sprintf("age %d is below 18", a))
+//	    })
+func (r Result[T]) FilterWith(pred func(T) bool, onFail func(T) ErrorType) Result[T] {
+	if r.isOk && !pred(r.value) {
+		return Err[T](onFail(r.value))
+	}
+	return r
+}
+
+// MapFallible transforms the success value via a function that can itself
+// fail in the standard Go (U, error) style. On Ok it runs f and maps a
+// returned error onto the error track: ErrorType errors are preserved as-is,
+// any other error becomes a ValidationError (the transform rejected the
+// value). Errors propagate without calling f.
+//
+// This sits between Map (infallible transform) and AndThenTo (transform
+// already speaking Result); use it to lift stdlib-style parsers and
+// converters into a chain without hand-written adapters.
+//
+// Example:
+//
+//	ageResult := MapFallible(inputResult, strconv.Atoi)
+func MapFallible[T any, U any](r Result[T], f func(T) (U, error)) Result[U] {
+	if !r.isOk {
+		return Err[U](r.err)
+	}
+	v, err := f(r.value)
+	if err != nil {
+		// Preserve typed domain errors; treat everything else as a rejection
+		if et, ok := err.(ErrorType); ok {
+			return Err[U](et)
+		}
+		return Err[U](NewValidationError(err.Error()))
+	}
+	return Ok(v)
+}
+
 // MapError transforms the error value if Error, propagates Ok if Ok.
 // Use to add context to errors as they propagate up call stack.
 //
@@ -258,12 +331,240 @@ func (r Result[T]) MapError(f func(ErrorType) ErrorType) Result[T] {
 	return r
 }
 
+// ToInfrastructure rekinds any error to InfrastructureError, preserving the
+// message. Ok Results pass through unchanged. Use at layer boundaries that
+// must normalize error kinds before crossing them.
+//
+// Example:
+//
+//	result := ToInfrastructure(adapterCall())
+func ToInfrastructure[T any](r Result[T]) Result[T] {
+	return r.MapError(func(e ErrorType) ErrorType {
+		return ErrorType{Kind: InfrastructureError, Message: e.Message}
+	})
+}
+
+// ToValidation rekinds any error to ValidationError, preserving the message.
+// Ok Results pass through unchanged.
+//
+// Example:
+//
+//	result := ToValidation(parseInput(raw))
+func ToValidation[T any](r Result[T]) Result[T] {
+	return r.MapError(func(e ErrorType) ErrorType {
+		return ErrorType{Kind: ValidationError, Message: e.Message}
+	})
+}
+
+// Equal reports whether two Results are equivalent: both Ok with equal
+// values, or both Err with equal Kind and Message. Ok/Err mixes are never
+// equal. This replaces the IsOk-branch-then-compare dance in tests.
+//
+// Example:
+//
+//	if !Equal(got, Ok(42)) {
+//	    t.Errorf("unexpected result")
+//	}
+func Equal[T comparable](a, b Result[T]) bool {
+	if a.isOk != b.isOk {
+		return false
+	}
+	if a.isOk {
+		return a.value == b.value
+	}
+	return a.err.Kind == b.err.Kind && a.err.Message == b.err.Message
+}
+
+// ============================================================================
+// Combining independent Results
+// ============================================================================
+
+// Pair carries two independently produced values through one Result.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines two independent Results into one carrying a Pair.
+//
+// If both are Ok, returns Ok(Pair{a, b}). If either is Err, returns the
+// first error in argument order: a's error is checked first, so when BOTH
+// fail, a's error wins and b's error is discarded. Use a MultiError when
+// all failures must be reported.
+//
+// Example:
+//
+//	combined := Zip(CreatePerson(name), CreateEmail(address))
+//	if combined.IsOk() {
+//	    pair := combined.Value() // pair.First, pair.Second
+//	}
+func Zip[A any, B any](a Result[A], b Result[B]) Result[Pair[A, B]] {
+	if !a.isOk {
+		return Err[Pair[A, B]](a.err)
+	}
+	if !b.isOk {
+		return Err[Pair[A, B]](b.err)
+	}
+	return Ok(Pair[A, B]{First: a.value, Second: b.value})
+}
+
+// Collect turns a slice of Results into a Result of slice with
+// all-or-nothing semantics: Ok with every value (in slice order) when all
+// elements are Ok, otherwise the first Err encountered in slice order.
+// An empty input yields Ok of an empty slice.
+//
+// Example:
+//
+//	results := []Result[Person]{CreatePerson("Alice"), CreatePerson("Bob")}
+//	people := Collect(results) // Ok([]Person) or first validation error
+func Collect[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if !r.isOk {
+			return Err[[]T](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok(values)
+}
+
+// Partition separates a batch of Results into the Ok values and the Err
+// ErrorTypes in a single pass, preserving input order within each slice.
+// Complementary to Collect: nothing is discarded and nothing short-circuits,
+// so every good value can be used while every failure is reported.
+//
+// Both returned slices are non-nil, even when empty. Both are pre-sized to
+// len(results) — the known maximum either side can reach — so a batch is
+// partitioned with exactly two allocations, never a reallocation.
+//
+// Example:
+//
+//	people, errs := Partition(personResults)
+//	for _, p := range people { greet(p) }
+//	for _, e := range errs { log.Println(e.Message) }
+func Partition[T any](results []Result[T]) (values []T, errs []ErrorType) {
+	values = make([]T, 0, len(results))
+	errs = make([]ErrorType, 0, len(results))
+	for _, r := range results {
+		if r.isOk {
+			values = append(values, r.value)
+		} else {
+			errs = append(errs, r.err)
+		}
+	}
+	return values, errs
+}
+
+// CollectErrors returns only the failing indices of a batch, mapped to
+// their errors, so callers can correlate failures back to specific inputs.
+// An all-Ok batch yields an empty non-nil map.
+//
+// Complementary to Partition, which keeps the values but forgets which
+// input each error came from.
+//
+// Example:
+//
+//	for i, e := range CollectErrors(results) {
+//	    fmt.Printf("input %d: %s\n", i, e.Message)
+//	}
+func CollectErrors[T any](results []Result[T]) map[int]ErrorType {
+	errs := make(map[int]ErrorType)
+	for i, r := range results {
+		if !r.isOk {
+			errs[i] = r.err
+		}
+	}
+	return errs
+}
+
+// PartitionByKind separates a batch of Results into the Ok values and the
+// errors grouped by ErrorKind, preserving input order within each group.
+// Unlike Collect it never short-circuits: every element is inspected, so
+// callers can both use the good values and report failure counts per kind.
+//
+// The returned map contains entries only for kinds that actually occurred.
+//
+// Example:
+//
+//	values, byKind := PartitionByKind(results)
+//	fmt.Printf("ok: %d, invalid: %d\n", len(values), len(byKind[ValidationError]))
+func PartitionByKind[T any](results []Result[T]) ([]T, map[ErrorKind][]ErrorType) {
+	values := make([]T, 0, len(results))
+	byKind := make(map[ErrorKind][]ErrorType)
+	for _, r := range results {
+		if r.isOk {
+			values = append(values, r.value)
+			continue
+		}
+		byKind[r.err.Kind] = append(byKind[r.err.Kind], r.err)
+	}
+	return values, byKind
+}
+
+// ============================================================================
+// Exhaustive handling
+// ============================================================================
+
+// Fold collapses both tracks of a Result into a single value - the
+// canonical catamorphism over the Ok/Err sum type. Exactly one of okFn and
+// errFn is evaluated, chosen by the Result state, and its value is returned.
+// Like Match it never touches the unsafe accessors, so it cannot panic.
+//
+// Fold is the terminal combinator: use it at the end of a chain where the
+// railway stops and an ordinary value (an exit code, an HTTP status, a log
+// line) is needed.
+//
+// Example:
+//
+//	status := Fold(writeResult,
+//	    func(model.Unit) int { return 0 },
+//	    func(e ErrorType) int { return 1 })
+func Fold[T any, R any](r Result[T], okFn func(T) R, errFn func(ErrorType) R) R {
+	if r.isOk {
+		return okFn(r.value)
+	}
+	return errFn(r.err)
+}
+
+// Match dispatches to exactly one branch based on the Result state and
+// returns that branch's value. It never calls the unsafe accessors, so it
+// cannot panic regardless of state - unlike hand-written IsOk branching
+// where flipping the condition panics at runtime.
+//
+// Example:
+//
+//	status := Match(result,
+//	    func(v User) string { return "found " + v.Name },
+//	    func(e ErrorType) string { return "failed: " + e.Message })
+func Match[T any, R any](r Result[T], onOk func(T) R, onErr func(ErrorType) R) R {
+	if r.isOk {
+		return onOk(r.value)
+	}
+	return onErr(r.err)
+}
+
+// Match is the method form of the package-level Match. Go methods cannot
+// introduce new type parameters, so the branch result type is fixed to T;
+// use the free function when the branches map to a different type.
+//
+// Example:
+//
+//	message := result.Match(
+//	    func(s string) string { return s },
+//	    func(e ErrorType) string { return "error: " + e.Message })
+func (r Result[T]) Match(onOk func(T) T, onErr func(ErrorType) T) T {
+	if r.isOk {
+		return onOk(r.value)
+	}
+	return onErr(r.err)
+}
+
 // ============================================================================
 // Fallback and recovery
 // ============================================================================
 
 // Fallback tries Primary, if Error then uses Alternative.
-// Both are eagerly evaluated.
+// Both are eagerly evaluated. Or is a synonym.
 //
 // Example:
 //
@@ -275,6 +576,17 @@ func (r Result[T]) Fallback(alternative Result[T]) Result[T] {
 	return alternative
 }
 
+// Or returns the receiver when it is Ok, otherwise alt. It is a synonym
+// for Fallback, named to match Option.Or so the two monads read alike:
+//
+//	person := CreatePerson(input).Or(CreatePerson("stranger"))
+//
+// Both arguments are eagerly evaluated; use FallbackWith when the
+// alternative is expensive to compute.
+func (r Result[T]) Or(alt Result[T]) Result[T] {
+	return r.Fallback(alt)
+}
+
 // FallbackWith tries Self, if Error then computes alternative lazily via f.
 // Use when alternative is expensive to compute.
 //
@@ -288,6 +600,50 @@ func (r Result[T]) FallbackWith(f func() Result[T]) Result[T] {
 	return f()
 }
 
+// First evaluates producers lazily in order and returns the first Ok,
+// or the last Err when every producer fails. Producers after the first
+// success are never invoked, so expensive alternatives cost nothing when
+// an earlier one delivers - this generalizes FallbackWith to any number
+// of lazy alternatives.
+//
+// Calling First with no producers is a programming error and yields a
+// ValidationError.
+//
+// Example:
+//
+//	result := First(
+//	    func() Result[Config] { return loadFromEnv() },
+//	    func() Result[Config] { return loadFromFile(path) },
+//	    func() Result[Config] { return defaults() })
+func First[T any](producers ...func() Result[T]) Result[T] {
+	if len(producers) == 0 {
+		return Err[T](NewValidationError("no producers supplied"))
+	}
+
+	var last Result[T]
+	for _, produce := range producers {
+		last = produce()
+		if last.isOk {
+			return last
+		}
+	}
+	return last
+}
+
+// RecoverOr turns any error into Ok(def), keeping the Result type so the
+// chain can continue (unlike Recover which returns a bare T).
+// Always returns an Ok Result.
+//
+// Example:
+//
+//	result := operation().RecoverOr(defaultValue).Map(transform)
+func (r Result[T]) RecoverOr(def T) Result[T] {
+	if r.isOk {
+		return r
+	}
+	return Ok(def)
+}
+
 // Recover turns error into value via handle function.
 // Always returns T (never fails).
 //
@@ -314,6 +670,67 @@ func (r Result[T]) RecoverWith(handle func(ErrorType) Result[T]) Result[T] {
 	return handle(r.err)
 }
 
+// RecoverByKind returns the value on Ok, or the default mapped to the
+// error's kind. An error kind absent from defaults yields T's zero value:
+// callers supplying a partial map get the same graceful degradation as a
+// zero-valued map lookup, rather than a panic deep in a recovery path.
+//
+// Example:
+//
+//	msg := result.RecoverByKind(map[ErrorKind]string{
+//	    ValidationError:     "please check your input",
+//	    InfrastructureError: "try again later",
+//	})
+func (r Result[T]) RecoverByKind(defaults map[ErrorKind]T) T {
+	if r.isOk {
+		return r.value
+	}
+	return defaults[r.err.Kind]
+}
+
+// CatchKind is a selective RecoverWith: it returns a catcher that invokes the
+// handler only when the Result's error Kind is one of the listed kinds.
+// Ok Results and errors of other kinds pass through unchanged.
+//
+// The curried form keeps the (typically short) kind list visually separate
+// from the (typically longer) handler at the call site.
+//
+// Example:
+//
+//	result := operation().CatchKind(ValidationError)(func(e ErrorType) Result[string] {
+//	    return Ok("fallback")
+//	})
+func (r Result[T]) CatchKind(kinds ...ErrorKind) func(func(ErrorType) Result[T]) Result[T] {
+	return func(handle func(ErrorType) Result[T]) Result[T] {
+		if r.isOk {
+			return r
+		}
+		for _, kind := range kinds {
+			if r.err.Kind == kind {
+				return handle(r.err)
+			}
+		}
+		return r
+	}
+}
+
+// ============================================================================
+// Iteration interop
+// ============================================================================
+
+// ToSlice flattens the Result into a slice: one element for Ok, empty for
+// Err. This makes Results compose with slice-based code:
+//
+//	acc = append(acc, r.ToSlice()...)
+//
+// The returned slice is always non-nil.
+func (r Result[T]) ToSlice() []T {
+	if r.isOk {
+		return []T{r.value}
+	}
+	return []T{}
+}
+
 // ============================================================================
 // Side effects (for logging/debugging)
 // ============================================================================
@@ -335,3 +752,49 @@ func (r Result[T]) Tap(onOk func(T), onError func(ErrorType)) Result[T] {
 	}
 	return r
 }
+
+// Inspect runs f with the value only when the Result is Ok, returning the
+// receiver unchanged for chaining. Use it for success-side logging without
+// the no-op error callback Tap would require.
+//
+// Example:
+//
+//	result := operation().Inspect(func(v T) { log.Info("got", v) })
+func (r Result[T]) Inspect(f func(T)) Result[T] {
+	if r.isOk {
+		f(r.value)
+	}
+	return r
+}
+
+// InspectErr runs f with the error only when the Result is Err, returning
+// the receiver unchanged for chaining. The error-side counterpart of
+// Inspect.
+//
+// Example:
+//
+//	result := operation().InspectErr(func(e ErrorType) { log.Error(e.Message) })
+func (r Result[T]) InspectErr(f func(ErrorType)) Result[T] {
+	if !r.isOk {
+		f(r.err)
+	}
+	return r
+}
+
+// OnComplete invokes f with the whole Result (Ok or Err) and returns the
+// Result unchanged for chaining.
+//
+// Unlike Tap, which takes separate callbacks per track, OnComplete takes a
+// single callback receiving the Result itself, which makes it defer-friendly
+// for log-at-exit patterns.
+//
+// Example:
+//
+//	func doWork() (result Result[int]) {
+//	    defer func() { result.OnComplete(logOutcome) }()
+//	    ...
+//	}
+func (r Result[T]) OnComplete(f func(Result[T])) Result[T] {
+	f(r)
+	return r
+}
@@ -0,0 +1,955 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// Package error_test provides unit tests for Result combinators added after
+// the core monad, using the Ada-style test framework for consistent
+// cross-language reporting.
+package error_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorResultRecoverOr tests the RecoverOr combinator.
+func TestDomainErrorResultRecoverOr(t *testing.T) {
+	tf := test.New("Domain.Error.Result.RecoverOr")
+
+	// ========================================================================
+	// Test: RecoverOr on Err becomes Ok(def)
+	// ========================================================================
+
+	r1 := domerr.Err[int](domerr.NewValidationError("bad input")).RecoverOr(99)
+	tf.RunTest("RecoverOr on Err - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("RecoverOr on Err - value is the default", r1.Value() == 99)
+	}
+
+	// ========================================================================
+	// Test: RecoverOr on Ok is unchanged
+	// ========================================================================
+
+	r2 := domerr.Ok(42).RecoverOr(99)
+	tf.RunTest("RecoverOr on Ok - IsOk returns true", r2.IsOk())
+	if r2.IsOk() {
+		tf.RunTest("RecoverOr on Ok - original value kept", r2.Value() == 42)
+	}
+
+	// ========================================================================
+	// Test: RecoverOr enables continued chaining
+	// ========================================================================
+
+	r3 := domerr.Err[int](domerr.NewInfrastructureError("boom")).
+		RecoverOr(10).
+		Map(func(x int) int { return x * 2 })
+	tf.RunTest("RecoverOr chains - IsOk returns true", r3.IsOk())
+	if r3.IsOk() {
+		tf.RunTest("RecoverOr chains - Map applied to default", r3.Value() == 20)
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultMapIf tests the MapIf conditional transform.
+func TestDomainErrorResultMapIf(t *testing.T) {
+	tf := test.New("Domain.Error.Result.MapIf")
+
+	double := func(x int) int { return x * 2 }
+
+	// ========================================================================
+	// Test: Ok with cond true applies the transform
+	// ========================================================================
+
+	r1 := domerr.Ok(21).MapIf(true, double)
+	tf.RunTest("Ok + cond true - transform applied",
+		r1.IsOk() && r1.Value() == 42)
+
+	// ========================================================================
+	// Test: Ok with cond false skips the transform
+	// ========================================================================
+
+	r2 := domerr.Ok(21).MapIf(false, double)
+	tf.RunTest("Ok + cond false - value unchanged",
+		r2.IsOk() && r2.Value() == 21)
+
+	// ========================================================================
+	// Test: Err propagates regardless of cond
+	// ========================================================================
+
+	errResult := domerr.Err[int](domerr.NewValidationError("bad"))
+	r3 := errResult.MapIf(true, double)
+	tf.RunTest("Err + cond true - error propagates", r3.IsError())
+	r4 := errResult.MapIf(false, double)
+	tf.RunTest("Err + cond false - error propagates", r4.IsError())
+
+	// ========================================================================
+	// Test: f is not invoked when cond is false
+	// ========================================================================
+
+	invoked := false
+	domerr.Ok(1).MapIf(false, func(x int) int {
+		invoked = true
+		return x
+	})
+	tf.RunTest("Cond false - f not invoked", !invoked)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultCatchKind tests the selective CatchKind recovery.
+func TestDomainErrorResultCatchKind(t *testing.T) {
+	tf := test.New("Domain.Error.Result.CatchKind")
+
+	recover99 := func(e domerr.ErrorType) domerr.Result[int] { return domerr.Ok(99) }
+
+	// ========================================================================
+	// Test: Listed kind is caught and recovered
+	// ========================================================================
+
+	r1 := domerr.Err[int](domerr.NewValidationError("bad input")).
+		CatchKind(domerr.ValidationError)(recover99)
+	tf.RunTest("Listed kind - recovered to Ok", r1.IsOk() && r1.Value() == 99)
+
+	// ========================================================================
+	// Test: Unlisted kind propagates untouched
+	// ========================================================================
+
+	r2 := domerr.Err[int](domerr.NewInfrastructureError("boom")).
+		CatchKind(domerr.ValidationError)(recover99)
+	tf.RunTest("Unlisted kind - error propagates", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("Unlisted kind - original error preserved",
+			r2.ErrorInfo().Message == "boom")
+	}
+
+	// ========================================================================
+	// Test: Multiple kinds - both listed kinds are caught
+	// ========================================================================
+
+	catchBoth := func(r domerr.Result[int]) domerr.Result[int] {
+		return r.CatchKind(domerr.ValidationError, domerr.InfrastructureError)(recover99)
+	}
+	r3 := catchBoth(domerr.Err[int](domerr.NewValidationError("bad")))
+	r4 := catchBoth(domerr.Err[int](domerr.NewInfrastructureError("down")))
+	tf.RunTest("Multiple kinds - validation caught", r3.IsOk() && r3.Value() == 99)
+	tf.RunTest("Multiple kinds - infrastructure caught", r4.IsOk() && r4.Value() == 99)
+
+	// ========================================================================
+	// Test: Ok passes through without invoking the handler
+	// ========================================================================
+
+	invoked := false
+	r5 := domerr.Ok(42).CatchKind(domerr.ValidationError)(func(e domerr.ErrorType) domerr.Result[int] {
+		invoked = true
+		return domerr.Ok(0)
+	})
+	tf.RunTest("Ok - value unchanged", r5.IsOk() && r5.Value() == 42)
+	tf.RunTest("Ok - handler not invoked", !invoked)
+
+	// ========================================================================
+	// Test: Handler may return a different error
+	// ========================================================================
+
+	r6 := domerr.Err[int](domerr.NewValidationError("bad")).
+		CatchKind(domerr.ValidationError)(func(e domerr.ErrorType) domerr.Result[int] {
+		return domerr.Err[int](domerr.NewInfrastructureError("rethrown"))
+	})
+	tf.RunTest("Rethrow - handler error propagates",
+		r6.IsError() && r6.ErrorInfo().Message == "rethrown")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultMapFallible tests lifting (U, error) transforms.
+func TestDomainErrorResultMapFallible(t *testing.T) {
+	tf := test.New("Domain.Error.Result.MapFallible")
+
+	// ========================================================================
+	// Test: Ok with succeeding transform yields Ok of the new type
+	// ========================================================================
+
+	r1 := domerr.MapFallible(domerr.Ok("42"), strconv.Atoi)
+	tf.RunTest("Ok to Ok - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("Ok to Ok - transformed value", r1.Value() == 42)
+	}
+
+	// ========================================================================
+	// Test: Plain error from the transform becomes a ValidationError
+	// ========================================================================
+
+	r2 := domerr.MapFallible(domerr.Ok("not-a-number"), strconv.Atoi)
+	tf.RunTest("Plain error - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("Plain error - kind is validation",
+			r2.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: ErrorType errors from the transform are preserved as-is
+	// ========================================================================
+
+	r3 := domerr.MapFallible(domerr.Ok(1), func(x int) (string, error) {
+		return "", domerr.NewInfrastructureError("backend down")
+	})
+	tf.RunTest("ErrorType error - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("ErrorType error - kind preserved",
+			r3.ErrorInfo().Kind == domerr.InfrastructureError)
+		tf.RunTest("ErrorType error - message preserved",
+			r3.ErrorInfo().Message == "backend down")
+	}
+
+	// ========================================================================
+	// Test: Err passes through without invoking the transform
+	// ========================================================================
+
+	invoked := false
+	r4 := domerr.MapFallible(domerr.Err[string](domerr.NewValidationError("bad")),
+		func(s string) (int, error) {
+			invoked = true
+			return 0, nil
+		})
+	tf.RunTest("Err passthrough - IsError returns true", r4.IsError())
+	tf.RunTest("Err passthrough - transform not invoked", !invoked)
+	if r4.IsError() {
+		tf.RunTest("Err passthrough - original error kept",
+			r4.ErrorInfo().Message == "bad")
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultCollect tests all-or-nothing slice collection.
+func TestDomainErrorResultCollect(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Collect")
+
+	// ========================================================================
+	// Test: Empty input yields Ok of an empty slice
+	// ========================================================================
+
+	r1 := domerr.Collect([]domerr.Result[int]{})
+	tf.RunTest("Empty input - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("Empty input - empty slice", len(r1.Value()) == 0)
+	}
+
+	// ========================================================================
+	// Test: All-ok input yields all values in slice order
+	// ========================================================================
+
+	r2 := domerr.Collect([]domerr.Result[int]{
+		domerr.Ok(1), domerr.Ok(2), domerr.Ok(3),
+	})
+	tf.RunTest("All ok - IsOk returns true", r2.IsOk())
+	if r2.IsOk() {
+		values := r2.Value()
+		tf.RunTest("All ok - values in order",
+			len(values) == 3 && values[0] == 1 && values[1] == 2 && values[2] == 3)
+	}
+
+	// ========================================================================
+	// Test: First error in slice order wins
+	// ========================================================================
+
+	r3 := domerr.Collect([]domerr.Result[int]{
+		domerr.Ok(1),
+		domerr.Err[int](domerr.NewValidationError("second is bad")),
+		domerr.Err[int](domerr.NewValidationError("third is bad")),
+	})
+	tf.RunTest("First error wins - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("First error wins - earliest error returned",
+			r3.ErrorInfo().Message == "second is bad")
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultFilter tests predicate-based rejection in a chain.
+func TestDomainErrorResultFilter(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Filter")
+
+	isEven := func(x int) bool { return x%2 == 0 }
+	rejection := domerr.NewValidationError("value must be even")
+
+	// ========================================================================
+	// Test: Ok passing the predicate is unchanged
+	// ========================================================================
+
+	r1 := domerr.Ok(4).Filter(isEven, rejection)
+	tf.RunTest("Ok + pass - unchanged", r1.IsOk() && r1.Value() == 4)
+
+	// ========================================================================
+	// Test: Ok failing the predicate becomes Err(onFail)
+	// ========================================================================
+
+	r2 := domerr.Ok(3).Filter(isEven, rejection)
+	tf.RunTest("Ok + fail - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("Ok + fail - onFail error returned",
+			r2.ErrorInfo().Message == "value must be even")
+	}
+
+	// ========================================================================
+	// Test: Existing Err propagates without invoking the predicate
+	// ========================================================================
+
+	invoked := false
+	r3 := domerr.Err[int](domerr.NewInfrastructureError("down")).
+		Filter(func(x int) bool { invoked = true; return true }, rejection)
+	tf.RunTest("Err - propagates original error",
+		r3.IsError() && r3.ErrorInfo().Message == "down")
+	tf.RunTest("Err - predicate not invoked", !invoked)
+
+	// ========================================================================
+	// Test: FilterWith builds the error from the rejected value
+	// ========================================================================
+
+	r4 := domerr.Ok(7).FilterWith(isEven, func(x int) domerr.ErrorType {
+		return domerr.NewValidationError(fmt.Sprintf("%d is odd", x))
+	})
+	tf.RunTest("FilterWith + fail - error references value",
+		r4.IsError() && r4.ErrorInfo().Message == "7 is odd")
+
+	lazyInvoked := false
+	r5 := domerr.Ok(8).FilterWith(isEven, func(x int) domerr.ErrorType {
+		lazyInvoked = true
+		return rejection
+	})
+	tf.RunTest("FilterWith + pass - unchanged", r5.IsOk() && r5.Value() == 8)
+	tf.RunTest("FilterWith + pass - onFail not invoked", !lazyInvoked)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultToSlice tests Result slice flattening.
+func TestDomainErrorResultToSlice(t *testing.T) {
+	tf := test.New("Domain.Error.Result.ToSlice")
+
+	// ========================================================================
+	// Test: Ok yields a one-element slice with the value
+	// ========================================================================
+
+	s1 := domerr.Ok(42).ToSlice()
+	tf.RunTest("Ok - one element", len(s1) == 1)
+	tf.RunTest("Ok - element is the value", len(s1) == 1 && s1[0] == 42)
+
+	// ========================================================================
+	// Test: Err yields a non-nil empty slice
+	// ========================================================================
+
+	s2 := domerr.Err[int](domerr.NewValidationError("bad")).ToSlice()
+	tf.RunTest("Err - non-nil empty slice", s2 != nil && len(s2) == 0)
+
+	// ========================================================================
+	// Test: Flattening many Results via append
+	// ========================================================================
+
+	results := []domerr.Result[int]{
+		domerr.Ok(1),
+		domerr.Err[int](domerr.NewValidationError("bad")),
+		domerr.Ok(2),
+	}
+	var acc []int
+	for _, r := range results {
+		acc = append(acc, r.ToSlice()...)
+	}
+	tf.RunTest("Flatten - only Ok values accumulate",
+		len(acc) == 2 && acc[0] == 1 && acc[1] == 2)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultPartition tests single-pass Ok/Err separation.
+func TestDomainErrorResultPartition(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Partition")
+
+	// ========================================================================
+	// Test: Mixed input preserves order within each slice
+	// ========================================================================
+
+	values, errs := domerr.Partition([]domerr.Result[int]{
+		domerr.Ok(1),
+		domerr.Err[int](domerr.NewValidationError("first bad")),
+		domerr.Ok(2),
+		domerr.Err[int](domerr.NewInfrastructureError("second bad")),
+		domerr.Ok(3),
+	})
+	tf.RunTest("Mixed - values in input order",
+		len(values) == 3 && values[0] == 1 && values[1] == 2 && values[2] == 3)
+	tf.RunTest("Mixed - errors in input order",
+		len(errs) == 2 && errs[0].Message == "first bad" && errs[1].Message == "second bad")
+
+	// ========================================================================
+	// Test: All-ok input yields a non-nil empty error slice
+	// ========================================================================
+
+	values, errs = domerr.Partition([]domerr.Result[int]{domerr.Ok(7)})
+	tf.RunTest("All ok - single value", len(values) == 1 && values[0] == 7)
+	tf.RunTest("All ok - errs non-nil and empty", errs != nil && len(errs) == 0)
+
+	// ========================================================================
+	// Test: All-err input yields a non-nil empty value slice
+	// ========================================================================
+
+	values, errs = domerr.Partition([]domerr.Result[int]{
+		domerr.Err[int](domerr.NewValidationError("bad")),
+	})
+	tf.RunTest("All err - values non-nil and empty", values != nil && len(values) == 0)
+	tf.RunTest("All err - single error", len(errs) == 1)
+
+	// ========================================================================
+	// Test: Empty input yields two non-nil empty slices
+	// ========================================================================
+
+	values, errs = domerr.Partition([]domerr.Result[int]{})
+	tf.RunTest("Empty - both non-nil and empty",
+		values != nil && errs != nil && len(values) == 0 && len(errs) == 0)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultZip tests combining two independent Results.
+func TestDomainErrorResultZip(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Zip")
+
+	// ========================================================================
+	// Test: Ok/Ok yields Ok carrying both values in order
+	// ========================================================================
+
+	r1 := domerr.Zip(domerr.Ok(42), domerr.Ok("hello"))
+	tf.RunTest("Ok/Ok - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		pair := r1.Value()
+		tf.RunTest("Ok/Ok - First carries a's value", pair.First == 42)
+		tf.RunTest("Ok/Ok - Second carries b's value", pair.Second == "hello")
+	}
+
+	// ========================================================================
+	// Test: Ok/Err yields b's error
+	// ========================================================================
+
+	r2 := domerr.Zip(domerr.Ok(42),
+		domerr.Err[string](domerr.NewValidationError("b failed")))
+	tf.RunTest("Ok/Err - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("Ok/Err - b's error returned", r2.ErrorInfo().Message == "b failed")
+	}
+
+	// ========================================================================
+	// Test: Err/Ok yields a's error
+	// ========================================================================
+
+	r3 := domerr.Zip(domerr.Err[int](domerr.NewValidationError("a failed")),
+		domerr.Ok("hello"))
+	tf.RunTest("Err/Ok - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("Err/Ok - a's error returned", r3.ErrorInfo().Message == "a failed")
+	}
+
+	// ========================================================================
+	// Test: Err/Err - a's error wins (documented short-circuit order)
+	// ========================================================================
+
+	r4 := domerr.Zip(domerr.Err[int](domerr.NewValidationError("a failed")),
+		domerr.Err[string](domerr.NewInfrastructureError("b failed")))
+	tf.RunTest("Err/Err - IsError returns true", r4.IsError())
+	if r4.IsError() {
+		tf.RunTest("Err/Err - a's error wins", r4.ErrorInfo().Message == "a failed")
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultFold tests the terminal Fold catamorphism.
+func TestDomainErrorResultFold(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Fold")
+
+	// ========================================================================
+	// Test: Ok evaluates okFn only and returns its value
+	// ========================================================================
+
+	okCalls, errCalls := 0, 0
+	code1 := domerr.Fold(domerr.Ok(42),
+		func(x int) int { okCalls++; return 0 },
+		func(e domerr.ErrorType) int { errCalls++; return 1 })
+	tf.RunTest("Ok - okFn value returned", code1 == 0)
+	tf.RunTest("Ok - exactly one function evaluated", okCalls == 1 && errCalls == 0)
+
+	// ========================================================================
+	// Test: Err evaluates errFn only and returns its value
+	// ========================================================================
+
+	okCalls, errCalls = 0, 0
+	code2 := domerr.Fold(domerr.Err[int](domerr.NewInfrastructureError("down")),
+		func(x int) int { okCalls++; return 0 },
+		func(e domerr.ErrorType) int { errCalls++; return 1 })
+	tf.RunTest("Err - errFn value returned", code2 == 1)
+	tf.RunTest("Err - exactly one function evaluated", okCalls == 0 && errCalls == 1)
+
+	// ========================================================================
+	// Test: Fold changes type without panicking on either state
+	// ========================================================================
+
+	describe := func(r domerr.Result[int]) string {
+		return domerr.Fold(r,
+			func(x int) string { return "ok" },
+			func(e domerr.ErrorType) string { return "err: " + e.Message })
+	}
+	tf.RunTest("Type change - Ok state", describe(domerr.Ok(7)) == "ok")
+	tf.RunTest("Type change - Err state",
+		describe(domerr.Err[int](domerr.NewValidationError("bad"))) == "err: bad")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultMatch tests exhaustive Match dispatch.
+func TestDomainErrorResultMatch(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Match")
+
+	// ========================================================================
+	// Test: Ok dispatches to the onOk branch only
+	// ========================================================================
+
+	okCalls, errCalls := 0, 0
+	v1 := domerr.Match(domerr.Ok(21),
+		func(x int) int { okCalls++; return x * 2 },
+		func(e domerr.ErrorType) int { errCalls++; return -1 })
+	tf.RunTest("Ok - onOk branch returns", v1 == 42)
+	tf.RunTest("Ok - exactly one branch ran", okCalls == 1 && errCalls == 0)
+
+	// ========================================================================
+	// Test: Err dispatches to the onErr branch only
+	// ========================================================================
+
+	okCalls, errCalls = 0, 0
+	v2 := domerr.Match(domerr.Err[int](domerr.NewValidationError("bad")),
+		func(x int) string { okCalls++; return "ok" },
+		func(e domerr.ErrorType) string { errCalls++; return "failed: " + e.Message })
+	tf.RunTest("Err - onErr branch returns", v2 == "failed: bad")
+	tf.RunTest("Err - exactly one branch ran", okCalls == 0 && errCalls == 1)
+
+	// ========================================================================
+	// Test: Nested Match mapping to different types
+	// ========================================================================
+
+	classify := func(r domerr.Result[int]) string {
+		return domerr.Match(r,
+			func(x int) string {
+				inner := domerr.Ok(x > 0)
+				return domerr.Match(inner,
+					func(positive bool) string {
+						if positive {
+							return "positive"
+						}
+						return "non-positive"
+					},
+					func(e domerr.ErrorType) string { return "inner error" })
+			},
+			func(e domerr.ErrorType) string { return "outer error" })
+	}
+	tf.RunTest("Nested - positive value", classify(domerr.Ok(5)) == "positive")
+	tf.RunTest("Nested - non-positive value", classify(domerr.Ok(-5)) == "non-positive")
+	tf.RunTest("Nested - outer error",
+		classify(domerr.Err[int](domerr.NewValidationError("bad"))) == "outer error")
+
+	// ========================================================================
+	// Test: Method form dispatches with result type fixed to T
+	// ========================================================================
+
+	m1 := domerr.Ok("hello").Match(
+		func(s string) string { return s + "!" },
+		func(e domerr.ErrorType) string { return "error" })
+	tf.RunTest("Method form - Ok branch", m1 == "hello!")
+
+	m2 := domerr.Err[string](domerr.NewInfrastructureError("down")).Match(
+		func(s string) string { return s },
+		func(e domerr.ErrorType) string { return "error: " + e.Message })
+	tf.RunTest("Method form - Err branch", m2 == "error: down")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultEqual tests the Result equality helper.
+func TestDomainErrorResultEqual(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Equal")
+
+	// ========================================================================
+	// Test: Ok values compare by equality
+	// ========================================================================
+
+	tf.RunTest("Ok/Ok equal values - true",
+		domerr.Equal(domerr.Ok(42), domerr.Ok(42)))
+	tf.RunTest("Ok/Ok differing values - false",
+		!domerr.Equal(domerr.Ok(42), domerr.Ok(43)))
+
+	// ========================================================================
+	// Test: Err values compare by Kind and Message
+	// ========================================================================
+
+	tf.RunTest("Err/Err same kind and message - true",
+		domerr.Equal(
+			domerr.Err[int](domerr.NewValidationError("bad")),
+			domerr.Err[int](domerr.NewValidationError("bad"))))
+	tf.RunTest("Err/Err differing message - false",
+		!domerr.Equal(
+			domerr.Err[int](domerr.NewValidationError("bad")),
+			domerr.Err[int](domerr.NewValidationError("worse"))))
+	tf.RunTest("Err/Err differing kind - false",
+		!domerr.Equal(
+			domerr.Err[int](domerr.NewValidationError("bad")),
+			domerr.Err[int](domerr.NewInfrastructureError("bad"))))
+
+	// ========================================================================
+	// Test: Ok/Err mixes are never equal
+	// ========================================================================
+
+	tf.RunTest("Ok vs Err - false",
+		!domerr.Equal(domerr.Ok(0), domerr.Err[int](domerr.NewValidationError("bad"))))
+	tf.RunTest("Err vs Ok - false",
+		!domerr.Equal(domerr.Err[int](domerr.NewValidationError("bad")), domerr.Ok(0)))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultInspect tests one-sided side-effect combinators.
+func TestDomainErrorResultInspect(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Inspect")
+
+	// ========================================================================
+	// Test: Inspect fires exactly once on Ok and sees the value
+	// ========================================================================
+
+	calls := 0
+	seen := 0
+	r1 := domerr.Ok(42).Inspect(func(v int) { calls++; seen = v })
+	tf.RunTest("Inspect on Ok - fired exactly once", calls == 1)
+	tf.RunTest("Inspect on Ok - callback sees value", seen == 42)
+	tf.RunTest("Inspect on Ok - receiver unchanged", r1.IsOk() && r1.Value() == 42)
+
+	// ========================================================================
+	// Test: Inspect never fires on Err
+	// ========================================================================
+
+	calls = 0
+	r2 := domerr.Err[int](domerr.NewValidationError("bad")).
+		Inspect(func(v int) { calls++ })
+	tf.RunTest("Inspect on Err - never fired", calls == 0)
+	tf.RunTest("Inspect on Err - error propagates", r2.IsError())
+
+	// ========================================================================
+	// Test: InspectErr fires exactly once on Err and sees the error
+	// ========================================================================
+
+	errCalls := 0
+	var seenErr domerr.ErrorType
+	r3 := domerr.Err[int](domerr.NewInfrastructureError("down")).
+		InspectErr(func(e domerr.ErrorType) { errCalls++; seenErr = e })
+	tf.RunTest("InspectErr on Err - fired exactly once", errCalls == 1)
+	tf.RunTest("InspectErr on Err - callback sees error", seenErr.Message == "down")
+	tf.RunTest("InspectErr on Err - receiver unchanged", r3.IsError())
+
+	// ========================================================================
+	// Test: InspectErr never fires on Ok
+	// ========================================================================
+
+	errCalls = 0
+	r4 := domerr.Ok(1).InspectErr(func(e domerr.ErrorType) { errCalls++ })
+	tf.RunTest("InspectErr on Ok - never fired", errCalls == 0)
+	tf.RunTest("InspectErr on Ok - value unchanged", r4.IsOk() && r4.Value() == 1)
+
+	// ========================================================================
+	// Test: Both chain together reading naturally
+	// ========================================================================
+
+	okSide, errSide := 0, 0
+	domerr.Ok("hello").
+		Inspect(func(string) { okSide++ }).
+		InspectErr(func(domerr.ErrorType) { errSide++ })
+	tf.RunTest("Chained - only the matching side fired", okSide == 1 && errSide == 0)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultOnComplete tests the OnComplete combinator.
+func TestDomainErrorResultOnComplete(t *testing.T) {
+	tf := test.New("Domain.Error.Result.OnComplete")
+
+	// ========================================================================
+	// Test: OnComplete on Ok receives the Ok Result
+	// ========================================================================
+
+	var seenOk domerr.Result[int]
+	calls := 0
+	r1 := domerr.Ok(42).OnComplete(func(r domerr.Result[int]) {
+		seenOk = r
+		calls++
+	})
+	tf.RunTest("OnComplete on Ok - callback invoked once", calls == 1)
+	tf.RunTest("OnComplete on Ok - callback sees Ok state", seenOk.IsOk())
+	tf.RunTest("OnComplete on Ok - callback sees value",
+		seenOk.IsOk() && seenOk.Value() == 42)
+	tf.RunTest("OnComplete on Ok - returns receiver unchanged",
+		r1.IsOk() && r1.Value() == 42)
+
+	// ========================================================================
+	// Test: OnComplete on Err receives the Err Result
+	// ========================================================================
+
+	var seenErr domerr.Result[int]
+	r2 := domerr.Err[int](domerr.NewValidationError("bad")).
+		OnComplete(func(r domerr.Result[int]) { seenErr = r })
+	tf.RunTest("OnComplete on Err - callback sees Err state", seenErr.IsError())
+	tf.RunTest("OnComplete on Err - callback sees error message",
+		seenErr.IsError() && seenErr.ErrorInfo().Message == "bad")
+	tf.RunTest("OnComplete on Err - returns receiver unchanged", r2.IsError())
+
+	// ========================================================================
+	// Test: OnComplete works in a defer (log-at-exit pattern)
+	// ========================================================================
+
+	var deferred domerr.Result[string]
+	func() (result domerr.Result[string]) {
+		defer func() { result.OnComplete(func(r domerr.Result[string]) { deferred = r }) }()
+		result = domerr.Ok("done")
+		return result
+	}()
+	tf.RunTest("OnComplete in defer - callback sees final Result",
+		deferred.IsOk() && deferred.Value() == "done")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultRekind tests the MapError presets.
+func TestDomainErrorResultRekind(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Rekind")
+
+	// ========================================================================
+	// Test: ToInfrastructure rekinds validation errors, keeping the message
+	// ========================================================================
+
+	r1 := domerr.ToInfrastructure(domerr.Err[int](domerr.NewValidationError("bad input")))
+	tf.RunTest("ToInfrastructure - kind changed",
+		r1.IsError() && r1.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("ToInfrastructure - message preserved",
+		r1.IsError() && r1.ErrorInfo().Message == "bad input")
+
+	// ========================================================================
+	// Test: ToValidation rekinds infrastructure errors, keeping the message
+	// ========================================================================
+
+	r2 := domerr.ToValidation(domerr.Err[int](domerr.NewInfrastructureError("disk full")))
+	tf.RunTest("ToValidation - kind changed",
+		r2.IsError() && r2.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("ToValidation - message preserved",
+		r2.IsError() && r2.ErrorInfo().Message == "disk full")
+
+	// ========================================================================
+	// Test: Ok Results pass through both presets unchanged
+	// ========================================================================
+
+	r3 := domerr.ToInfrastructure(domerr.Ok(7))
+	tf.RunTest("ToInfrastructure - Ok preserved", r3.IsOk() && r3.Value() == 7)
+	r4 := domerr.ToValidation(domerr.Ok("hello"))
+	tf.RunTest("ToValidation - Ok preserved", r4.IsOk() && r4.Value() == "hello")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultRecoverByKind tests kind-mapped recovery defaults.
+func TestDomainErrorResultRecoverByKind(t *testing.T) {
+	tf := test.New("Domain.Error.Result.RecoverByKind")
+
+	defaults := map[domerr.ErrorKind]string{
+		domerr.ValidationError: "please check your input",
+	}
+
+	// ========================================================================
+	// Test: Ok ignores the defaults map entirely
+	// ========================================================================
+
+	tf.RunTest("Ok - value returned",
+		domerr.Ok("hello").RecoverByKind(defaults) == "hello")
+
+	// ========================================================================
+	// Test: A mapped kind recovers to its default
+	// ========================================================================
+
+	r1 := domerr.Err[string](domerr.NewValidationError("bad name"))
+	tf.RunTest("Mapped kind - default returned",
+		r1.RecoverByKind(defaults) == "please check your input")
+
+	// ========================================================================
+	// Test: An unmapped kind yields the zero value, never panicking
+	// ========================================================================
+
+	r2 := domerr.Err[string](domerr.NewInfrastructureError("disk full"))
+	tf.RunTest("Unmapped kind - zero value returned",
+		r2.RecoverByKind(defaults) == "")
+
+	r3 := domerr.Err[int](domerr.NewInfrastructureError("disk full"))
+	tf.RunTest("Unmapped kind - zero value for int",
+		r3.RecoverByKind(map[domerr.ErrorKind]int{}) == 0)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultCollectErrors tests index-keyed error collection.
+func TestDomainErrorResultCollectErrors(t *testing.T) {
+	tf := test.New("Domain.Error.Result.CollectErrors")
+
+	// ========================================================================
+	// Test: Failures map to the indices of the inputs that produced them
+	// ========================================================================
+
+	results := []domerr.Result[int]{
+		domerr.Ok(1),
+		domerr.Err[int](domerr.NewValidationError("bad at one")),
+		domerr.Ok(3),
+		domerr.Err[int](domerr.NewInfrastructureError("bad at three")),
+	}
+	errs := domerr.CollectErrors(results)
+	tf.RunTest("Mixed batch - only failing indices present", len(errs) == 2)
+	e1, ok1 := errs[1]
+	e3, ok3 := errs[3]
+	tf.RunTest("Mixed batch - index 1 carries its error",
+		ok1 && e1.Message == "bad at one")
+	tf.RunTest("Mixed batch - index 3 carries its error",
+		ok3 && e3.Kind == domerr.InfrastructureError && e3.Message == "bad at three")
+	_, ok0 := errs[0]
+	tf.RunTest("Mixed batch - Ok indices absent", !ok0)
+
+	// ========================================================================
+	// Test: An all-Ok batch yields an empty non-nil map
+	// ========================================================================
+
+	allOk := domerr.CollectErrors([]domerr.Result[int]{domerr.Ok(1), domerr.Ok(2)})
+	tf.RunTest("All Ok - empty map", allOk != nil && len(allOk) == 0)
+
+	// ========================================================================
+	// Test: An empty batch behaves like an all-Ok batch
+	// ========================================================================
+
+	tf.RunTest("Empty input - empty map",
+		len(domerr.CollectErrors([]domerr.Result[int]{})) == 0)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultFirst tests lazy fallback chains over many producers.
+func TestDomainErrorResultFirst(t *testing.T) {
+	tf := test.New("Domain.Error.Result.First")
+
+	calls := 0
+	ok := func(v int) func() domerr.Result[int] {
+		return func() domerr.Result[int] { calls++; return domerr.Ok(v) }
+	}
+	fail := func(msg string) func() domerr.Result[int] {
+		return func() domerr.Result[int] {
+			calls++
+			return domerr.Err[int](domerr.NewInfrastructureError(msg))
+		}
+	}
+
+	// ========================================================================
+	// Test: The first success short-circuits later producers
+	// ========================================================================
+
+	calls = 0
+	r1 := domerr.First(ok(1), fail("unreached"), ok(3))
+	tf.RunTest("First succeeds - its value returned", r1.IsOk() && r1.Value() == 1)
+	tf.RunTest("First succeeds - later producers not called", calls == 1)
+
+	// ========================================================================
+	// Test: Failures are skipped until a producer delivers
+	// ========================================================================
+
+	calls = 0
+	r2 := domerr.First(fail("a"), ok(2), fail("unreached"))
+	tf.RunTest("Middle succeeds - its value returned", r2.IsOk() && r2.Value() == 2)
+	tf.RunTest("Middle succeeds - exactly two producers called", calls == 2)
+
+	// ========================================================================
+	// Test: When every producer fails, the last error wins
+	// ========================================================================
+
+	calls = 0
+	r3 := domerr.First(fail("a"), fail("b"), fail("c"))
+	tf.RunTest("All fail - error returned", r3.IsError())
+	tf.RunTest("All fail - last error wins",
+		r3.IsError() && r3.ErrorInfo().Message == "c")
+	tf.RunTest("All fail - every producer called", calls == 3)
+
+	// ========================================================================
+	// Test: No producers at all is rejected
+	// ========================================================================
+
+	r4 := domerr.First[int]()
+	tf.RunTest("No producers - ValidationError",
+		r4.IsError() && r4.ErrorInfo().Kind == domerr.ValidationError)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultOr tests the Option-style synonym for Fallback.
+func TestDomainErrorResultOr(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Or")
+
+	ok := domerr.Ok(1)
+	alt := domerr.Ok(2)
+	failed := domerr.Err[int](domerr.NewValidationError("first failed"))
+
+	// ========================================================================
+	// Test: The first Ok wins
+	// ========================================================================
+
+	r1 := ok.Or(alt)
+	tf.RunTest("Ok receiver - receiver returned", r1.IsOk() && r1.Value() == 1)
+
+	// ========================================================================
+	// Test: An Err receiver yields the alternative, Ok or not
+	// ========================================================================
+
+	r2 := failed.Or(alt)
+	tf.RunTest("Err receiver - alternative returned", r2.IsOk() && r2.Value() == 2)
+
+	otherErr := domerr.Err[int](domerr.NewInfrastructureError("second failed"))
+	r3 := failed.Or(otherErr)
+	tf.RunTest("Both Err - second error returned",
+		r3.IsError() && r3.ErrorInfo().Message == "second failed")
+
+	// ========================================================================
+	// Test: Or and Fallback are synonyms
+	// ========================================================================
+
+	tf.RunTest("Synonym - same result as Fallback on Ok",
+		domerr.Equal(ok.Or(alt), ok.Fallback(alt)))
+	tf.RunTest("Synonym - same result as Fallback on Err",
+		domerr.Equal(failed.Or(alt), failed.Fallback(alt)))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,49 @@
+// ===========================================================================
+// traced.go
+// ===========================================================================
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// SPDX-License-Identifier: BSD-3-Clause
+//
+// Purpose:
+//   Opt-in pipeline breadcrumbs. Wrapping each stage of a chain with
+//   Traced leaves a trace of the failing path in the error's Fields, so a
+//   failure deep in a pipeline says where it happened without a debugger.
+//
+// Design Notes:
+//   - Zero cost on the Ok track: successful Results pass through untouched
+//   - The trace accumulates in the TraceField metadata entry as the error
+//     propagates through successive Traced steps
+// ===========================================================================
+
+package error
+
+// TraceField is the metadata key under which Traced accumulates the
+// breadcrumb of failing pipeline steps.
+const TraceField = "trace"
+
+// traceSeparator joins step names in the accumulated trace.
+const traceSeparator = " -> "
+
+// Traced labels a pipeline step: Ok Results pass through unchanged, while
+// an error has the step name appended to the trace in its Fields. An error
+// propagating through several Traced steps therefore carries the full
+// failing path, first step first.
+//
+// Example:
+//
+//	result := Traced("write",
+//	    AndThenTo(Traced("validate", CreatePerson(name)),
+//	        func(p Person) Result[Unit] { return w.Write(ctx, p.GreetingMessage()) }))
+//	// on failure: result.ErrorInfo().Field(TraceField) == "validate -> write"
+func Traced[T any](step string, r Result[T]) Result[T] {
+	if r.isOk {
+		return r
+	}
+
+	e := r.err
+	trace := step
+	if existing, ok := e.Field(TraceField); ok {
+		trace = existing + traceSeparator + step
+	}
+	return Err[T](e.WithField(TraceField, trace))
+}
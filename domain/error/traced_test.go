@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorFields tests structured metadata on ErrorType.
+func TestDomainErrorFields(t *testing.T) {
+	tf := test.New("Domain.Error.Fields")
+
+	// ========================================================================
+	// Test: Errors start without metadata
+	// ========================================================================
+
+	base := domerr.NewValidationError("bad input")
+	_, present := base.Field("trace")
+	tf.RunTest("Fresh error - no field present", !present)
+	tf.RunTest("Fresh error - Fields is empty non-nil map",
+		base.Fields() != nil && len(base.Fields()) == 0)
+
+	// ========================================================================
+	// Test: WithField is copy-on-write
+	// ========================================================================
+
+	enriched := base.WithField("duration_ms", "123")
+	v, ok := enriched.Field("duration_ms")
+	tf.RunTest("WithField - value retrievable", ok && v == "123")
+	_, leaked := base.Field("duration_ms")
+	tf.RunTest("WithField - receiver unchanged", !leaked)
+
+	// ========================================================================
+	// Test: Fields accumulate and later writes win per key
+	// ========================================================================
+
+	multi := enriched.WithField("attempt", "2").WithField("duration_ms", "456")
+	fields := multi.Fields()
+	tf.RunTest("Accumulate - both keys present", len(fields) == 2)
+	tf.RunTest("Accumulate - later write wins", fields["duration_ms"] == "456")
+	tf.RunTest("Accumulate - kind and message preserved",
+		multi.Kind == domerr.ValidationError && multi.Message == "bad input")
+
+	// ========================================================================
+	// Test: Field-free errors remain comparable with ==
+	// ========================================================================
+
+	tf.RunTest("Comparability - equal field-free errors",
+		domerr.NewValidationError("x") == domerr.NewValidationError("x"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorTraced tests pipeline breadcrumb accumulation.
+func TestDomainErrorTraced(t *testing.T) {
+	tf := test.New("Domain.Error.Traced")
+
+	// ========================================================================
+	// Test: Ok Results pass through Traced untouched
+	// ========================================================================
+
+	r1 := domerr.Traced("validate", domerr.Ok(42))
+	tf.RunTest("Ok - passes through", r1.IsOk() && r1.Value() == 42)
+
+	// ========================================================================
+	// Test: A failing step records its name in the trace field
+	// ========================================================================
+
+	r2 := domerr.Traced("validate",
+		domerr.Err[int](domerr.NewValidationError("bad name")))
+	tf.RunTest("Single failure - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		trace, ok := r2.ErrorInfo().Field(domerr.TraceField)
+		tf.RunTest("Single failure - trace records the step",
+			ok && trace == "validate")
+		tf.RunTest("Single failure - message preserved",
+			r2.ErrorInfo().Message == "bad name")
+	}
+
+	// ========================================================================
+	// Test: The trace accumulates across failing steps, first step first
+	// ========================================================================
+
+	failed := domerr.Err[int](domerr.NewInfrastructureError("disk full"))
+	r3 := domerr.Traced("write", domerr.Traced("enrich", domerr.Traced("validate", failed)))
+	if r3.IsError() {
+		trace, ok := r3.ErrorInfo().Field(domerr.TraceField)
+		tf.RunTest("Chained failures - full path accumulated",
+			ok && trace == "validate -> enrich -> write")
+	}
+
+	// ========================================================================
+	// Test: Traced composes with AndThenTo on the error track
+	// ========================================================================
+
+	r4 := domerr.Traced("stage2", domerr.AndThenTo(
+		domerr.Traced("stage1", domerr.Err[int](domerr.NewValidationError("bad"))),
+		func(x int) domerr.Result[string] { return domerr.Ok("unreachable") }))
+	if r4.IsError() {
+		trace, ok := r4.ErrorInfo().Field(domerr.TraceField)
+		tf.RunTest("With AndThenTo - trace survives propagation",
+			ok && trace == "stage1 -> stage2")
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
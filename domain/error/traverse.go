@@ -0,0 +1,103 @@
+// ===========================================================================
+// traverse.go
+// ===========================================================================
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// SPDX-License-Identifier: BSD-3-Clause
+//
+// Purpose:
+//   Bounded concurrent traversal over a slice with Result-producing work.
+//   The first error cancels remaining work and becomes the traversal's
+//   outcome, keeping the railway semantics of AndThenTo across goroutines.
+//
+// Design Notes:
+//   - Pure domain implementation (standard library only)
+//   - Input order is preserved in the output slice regardless of which
+//     worker finished first
+//   - Cancellation is cooperative: in-flight calls receive a cancelled
+//     context, queued items are never started
+// ===========================================================================
+
+package error
+
+import (
+	"context"
+	"sync"
+)
+
+// TraverseConcurrent runs f over items using at most workers goroutines and
+// collects the values in input order. On the first error, the shared context
+// is cancelled so queued items are never started, and that error is returned.
+//
+// The context passed to f is derived from ctx and is cancelled when any item
+// fails, so f implementations should honor ctx.Done() for prompt shutdown.
+//
+// A workers value below 1 is treated as 1 (sequential traversal).
+//
+// Example:
+//
+//	result := TraverseConcurrent(ctx, names, 4,
+//	    func(ctx context.Context, name string) Result[Person] {
+//	        return validate(ctx, name)
+//	    })
+func TraverseConcurrent[T any, U any](ctx context.Context, items []T, workers int, f func(context.Context, T) Result[U]) Result[[]U] {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	values := make([]U, len(items))
+	indexes := make(chan int)
+
+	var mu sync.Mutex
+	var firstErr ErrorType
+	failed := false
+
+	// Feeder: stops handing out work as soon as the context is cancelled,
+	// either by an item failure or by the caller.
+	go func() {
+		defer close(indexes)
+		for i := range items {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				r := f(ctx, items[idx])
+				if r.IsError() {
+					mu.Lock()
+					if !failed {
+						failed = true
+						firstErr = r.ErrorInfo()
+					}
+					mu.Unlock()
+					cancel()
+					continue
+				}
+				values[idx] = r.Value()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failed {
+		return Err[[]U](firstErr)
+	}
+	if err := ctx.Err(); err != nil {
+		return Err[[]U](NewInfrastructureError("traversal cancelled: " + err.Error()))
+	}
+	return Ok(values)
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorTraverseConcurrent tests bounded concurrent traversal.
+func TestDomainErrorTraverseConcurrent(t *testing.T) {
+	tf := test.New("Domain.Error.TraverseConcurrent")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: All-ok input preserves input order
+	// ========================================================================
+
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	r1 := domerr.TraverseConcurrent(ctx, items, 4,
+		func(ctx context.Context, x int) domerr.Result[int] {
+			return domerr.Ok(x * 10)
+		})
+	tf.RunTest("All ok - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		values := r1.Value()
+		ordered := len(values) == len(items)
+		for i := range values {
+			ordered = ordered && values[i] == items[i]*10
+		}
+		tf.RunTest("All ok - values in input order", ordered)
+	}
+
+	// ========================================================================
+	// Test: Empty input yields an empty Ok slice
+	// ========================================================================
+
+	r2 := domerr.TraverseConcurrent(ctx, []int{}, 4,
+		func(ctx context.Context, x int) domerr.Result[int] {
+			return domerr.Ok(x)
+		})
+	tf.RunTest("Empty input - IsOk with empty slice",
+		r2.IsOk() && len(r2.Value()) == 0)
+
+	// ========================================================================
+	// Test: First error cancels later work
+	// ========================================================================
+
+	many := make([]int, 100)
+	for i := range many {
+		many[i] = i
+	}
+	var invocations atomic.Int64
+	r3 := domerr.TraverseConcurrent(ctx, many, 2,
+		func(ctx context.Context, x int) domerr.Result[int] {
+			invocations.Add(1)
+			if x == 3 {
+				return domerr.Err[int](domerr.NewValidationError("item 3 is bad"))
+			}
+			// Small delay so cancellation observably outruns the feeder
+			time.Sleep(time.Millisecond)
+			return domerr.Ok(x)
+		})
+	tf.RunTest("Failure - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("Failure - first error is returned",
+			r3.ErrorInfo().Message == "item 3 is bad")
+	}
+	tf.RunTest("Failure - later work was cancelled",
+		invocations.Load() < int64(len(many)))
+
+	// ========================================================================
+	// Test: Pre-cancelled context yields an error without running all items
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	var ranCancelled atomic.Int64
+	r4 := domerr.TraverseConcurrent(cancelled, many, 2,
+		func(ctx context.Context, x int) domerr.Result[int] {
+			ranCancelled.Add(1)
+			return domerr.Ok(x)
+		})
+	tf.RunTest("Pre-cancelled - IsError returns true", r4.IsError())
+	tf.RunTest("Pre-cancelled - work was skipped",
+		ranCancelled.Load() < int64(len(many)))
+
+	// ========================================================================
+	// Test: Workers below 1 is treated as sequential, still correct
+	// ========================================================================
+
+	r5 := domerr.TraverseConcurrent(ctx, []int{1, 2, 3}, 0,
+		func(ctx context.Context, x int) domerr.Result[int] {
+			return domerr.Ok(x + 1)
+		})
+	tf.RunTest("Zero workers - IsOk with correct values",
+		r5.IsOk() && len(r5.Value()) == 3 && r5.Value()[0] == 2 && r5.Value()[2] == 4)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
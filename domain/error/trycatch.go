@@ -0,0 +1,70 @@
+// ===========================================================================
+// trycatch.go
+// ===========================================================================
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// SPDX-License-Identifier: BSD-3-Clause
+//
+// Purpose:
+//   Panic-to-Result bridges for wrapping panicky code into the Result
+//   monad. Centralizes the recover pattern that infrastructure adapters
+//   (e.g. ConsoleWriter) use at the exception boundary.
+//
+// Architecture Notes:
+//   - Generic over success type T
+//   - Pure domain implementation (ZERO external module dependencies)
+//   - Panics become InfrastructureError Results, never escape
+// ===========================================================================
+
+package error
+
+import "fmt"
+
+// TryCatch runs f, recovering from any panic and converting it to an
+// InfrastructureError Result. Returns Ok(f()) if f completes normally.
+//
+// Use this to bridge panicky third-party code into railway-oriented flows:
+//
+//	result := TryCatch(func() Config { return thirdparty.MustLoad(path) })
+//
+// Contract:
+//   - Post: Never panics; a panic in f yields Err(InfrastructureError)
+//     whose message contains the panic value
+func TryCatch[T any](f func() T) (result Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = Err[T](NewInfrastructureError(
+				fmt.Sprintf("panic recovered: %v", r)))
+		}
+	}()
+	return Ok(f())
+}
+
+// TryCatchErr runs a standard (T, error) function with panic safety,
+// mapping the outcome onto the Result monad:
+//
+//   - f returns (v, nil)            -> Ok(v)
+//   - f returns an ErrorType error  -> Err with that ErrorType preserved
+//   - f returns any other error     -> Err(InfrastructureError) wrapping it
+//   - f panics                      -> Err(InfrastructureError) with the panic value
+//
+// This is the bridge for stdlib-style APIs:
+//
+//	result := TryCatchErr(func() ([]byte, error) { return os.ReadFile(path) })
+func TryCatchErr[T any](f func() (T, error)) (result Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = Err[T](NewInfrastructureError(
+				fmt.Sprintf("panic recovered: %v", r)))
+		}
+	}()
+
+	v, err := f()
+	if err != nil {
+		// Preserve typed domain errors; wrap everything else
+		if et, ok := err.(ErrorType); ok {
+			return Err[T](et)
+		}
+		return Err[T](NewInfrastructureError(err.Error()))
+	}
+	return Ok(v)
+}
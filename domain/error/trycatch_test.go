@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorTryCatch tests the TryCatch panic-to-Result bridge.
+func TestDomainErrorTryCatch(t *testing.T) {
+	tf := test.New("Domain.Error.TryCatch")
+
+	// ========================================================================
+	// Test: Normal return yields Ok with the value
+	// ========================================================================
+
+	r1 := domerr.TryCatch(func() int { return 42 })
+	tf.RunTest("TryCatch normal - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("TryCatch normal - correct value", r1.Value() == 42)
+	}
+
+	// ========================================================================
+	// Test: Panicking function yields InfrastructureError with panic value
+	// ========================================================================
+
+	r2 := domerr.TryCatch(func() int { panic("kaboom") })
+	tf.RunTest("TryCatch panic - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		info := r2.ErrorInfo()
+		tf.RunTest("TryCatch panic - kind is InfrastructureError",
+			info.Kind == domerr.InfrastructureError)
+		tf.RunTest("TryCatch panic - message contains panic value",
+			strings.Contains(info.Message, "kaboom"))
+	}
+
+	// ========================================================================
+	// Test: Panic with a non-string value is formatted into the message
+	// ========================================================================
+
+	r3 := domerr.TryCatch(func() string { panic(1234) })
+	tf.RunTest("TryCatch non-string panic - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("TryCatch non-string panic - message contains value",
+			strings.Contains(r3.ErrorInfo().Message, "1234"))
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorTryCatchErr tests the TryCatchErr (T, error) bridge.
+func TestDomainErrorTryCatchErr(t *testing.T) {
+	tf := test.New("Domain.Error.TryCatchErr")
+
+	// ========================================================================
+	// Test: Success path yields Ok with the value
+	// ========================================================================
+
+	r1 := domerr.TryCatchErr(func() (int, error) { return 7, nil })
+	tf.RunTest("TryCatchErr success - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("TryCatchErr success - correct value", r1.Value() == 7)
+	}
+
+	// ========================================================================
+	// Test: Returned ErrorType is preserved (kind and message)
+	// ========================================================================
+
+	typed := domerr.NewValidationError("typed failure")
+	r2 := domerr.TryCatchErr(func() (int, error) { return 0, typed })
+	tf.RunTest("TryCatchErr ErrorType - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		info := r2.ErrorInfo()
+		tf.RunTest("TryCatchErr ErrorType - kind preserved",
+			info.Kind == domerr.ValidationError)
+		tf.RunTest("TryCatchErr ErrorType - message preserved",
+			info.Message == "typed failure")
+	}
+
+	// ========================================================================
+	// Test: Plain error is wrapped as InfrastructureError
+	// ========================================================================
+
+	r3 := domerr.TryCatchErr(func() (int, error) { return 0, errPlain })
+	tf.RunTest("TryCatchErr plain error - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		info := r3.ErrorInfo()
+		tf.RunTest("TryCatchErr plain error - kind is InfrastructureError",
+			info.Kind == domerr.InfrastructureError)
+		tf.RunTest("TryCatchErr plain error - message preserved",
+			info.Message == "plain failure")
+	}
+
+	// ========================================================================
+	// Test: Panic is recovered into InfrastructureError
+	// ========================================================================
+
+	r4 := domerr.TryCatchErr(func() (int, error) { panic("boom") })
+	tf.RunTest("TryCatchErr panic - IsError returns true", r4.IsError())
+	if r4.IsError() {
+		tf.RunTest("TryCatchErr panic - message contains panic value",
+			strings.Contains(r4.ErrorInfo().Message, "boom"))
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// errPlain is a non-ErrorType error used by the TryCatchErr tests.
+var errPlain = errors.New("plain failure")
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorWrap tests error chains built with ErrorType.Wrap.
+func TestDomainErrorWrap(t *testing.T) {
+	tf := test.New("Domain.Error.Wrap")
+
+	root := domerr.NewInfrastructureError("connection refused")
+	wrapped := domerr.NewInfrastructureError("greeting not delivered").Wrap(root)
+
+	// ========================================================================
+	// Test: Unwrap exposes the cause to the standard errors package
+	// ========================================================================
+
+	cause := errors.Unwrap(wrapped)
+	tf.RunTest("Unwrap - cause returned", cause != nil)
+	tf.RunTest("Unwrap - cause is the root error",
+		cause != nil && cause.Error() == root.Error())
+	tf.RunTest("errors.Is - finds the root through the chain",
+		errors.Is(wrapped, root))
+
+	var target domerr.ErrorType
+	tf.RunTest("errors.As - extracts ErrorType from the chain",
+		errors.As(errors.Unwrap(wrapped), &target) && target.Message == "connection refused")
+
+	// ========================================================================
+	// Test: Error() renders both layers
+	// ========================================================================
+
+	tf.RunTest("Error - both layers rendered",
+		wrapped.Error() == "InfrastructureError: greeting not delivered: InfrastructureError: connection refused")
+
+	// ========================================================================
+	// Test: Wrapping is copy-on-write and cause-free errors are unchanged
+	// ========================================================================
+
+	plain := domerr.NewValidationError("bad input")
+	tf.RunTest("No cause - Unwrap returns nil", errors.Unwrap(plain) == nil)
+	tf.RunTest("No cause - single-layer rendering",
+		plain.Error() == "ValidationError: bad input")
+
+	rewrapped := plain.Wrap(root)
+	tf.RunTest("Wrap - receiver unchanged", errors.Unwrap(plain) == nil)
+	tf.RunTest("Wrap - copy carries the cause", errors.Unwrap(rewrapped) != nil)
+
+	// ========================================================================
+	// Test: Wrap composes with MapError to add context
+	// ========================================================================
+
+	r := domerr.Err[int](root).MapError(func(e domerr.ErrorType) domerr.ErrorType {
+		return domerr.NewInfrastructureError("while greeting Alice").Wrap(e)
+	})
+	tf.RunTest("MapError - root preserved through context layer",
+		r.IsError() && errors.Is(r.ErrorInfo(), root))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorNotFoundKind tests the NotFoundError kind and constructor.
+func TestDomainErrorNotFoundKind(t *testing.T) {
+	tf := test.New("Domain.Error.NotFoundKind")
+
+	// ========================================================================
+	// Test: The constructor sets the kind and message
+	// ========================================================================
+
+	e := domerr.NewNotFoundError("person 42 does not exist")
+	tf.RunTest("Constructor - kind is NotFoundError", e.Kind == domerr.NotFoundError)
+	tf.RunTest("Constructor - message preserved",
+		e.Message == "person 42 does not exist")
+
+	// ========================================================================
+	// Test: String renders every kind distinctly
+	// ========================================================================
+
+	tf.RunTest("String - NotFoundError", domerr.NotFoundError.String() == "NotFoundError")
+	tf.RunTest("String - ValidationError unchanged",
+		domerr.ValidationError.String() == "ValidationError")
+	tf.RunTest("String - InfrastructureError unchanged",
+		domerr.InfrastructureError.String() == "InfrastructureError")
+
+	// ========================================================================
+	// Test: NotFound flows through the railway like any other kind
+	// ========================================================================
+
+	r := domerr.Err[int](e)
+	tf.RunTest("Railway - kind observable from Result",
+		r.IsError() && r.ErrorInfo().Kind == domerr.NotFoundError)
+	tf.RunTest("Error - rendered with the kind prefix",
+		e.Error() == "NotFoundError: person 42 does not exist")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorWithMessageContext tests the message-enrichment helpers.
+func TestDomainErrorWithMessageContext(t *testing.T) {
+	tf := test.New("Domain.Error.WithMessageContext")
+
+	base := domerr.NewInfrastructureError("connection refused")
+
+	// ========================================================================
+	// Test: WithMessage replaces the message, keeping everything else
+	// ========================================================================
+
+	renamed := base.WithMessage("upstream unavailable")
+	tf.RunTest("WithMessage - message replaced",
+		renamed.Message == "upstream unavailable")
+	tf.RunTest("WithMessage - kind preserved",
+		renamed.Kind == domerr.InfrastructureError)
+	tf.RunTest("WithMessage - receiver unchanged",
+		base.Message == "connection refused")
+
+	wrapped := base.Wrap(domerr.NewValidationError("root")).WithMessage("outer")
+	tf.RunTest("WithMessage - cause preserved",
+		errors.Unwrap(wrapped) != nil)
+
+	// ========================================================================
+	// Test: WithContext prepends "prefix: " to the existing message
+	// ========================================================================
+
+	contextual := base.WithContext("loading config")
+	tf.RunTest("WithContext - prefix prepended",
+		contextual.Message == "loading config: connection refused")
+	tf.RunTest("WithContext - kind preserved",
+		contextual.Kind == domerr.InfrastructureError)
+
+	layered := contextual.WithContext("startup")
+	tf.RunTest("WithContext - layers stack outermost first",
+		layered.Message == "startup: loading config: connection refused")
+
+	// ========================================================================
+	// Test: The helpers read cleanly inside MapError
+	// ========================================================================
+
+	r := domerr.Err[int](base).MapError(func(e domerr.ErrorType) domerr.ErrorType {
+		return e.WithContext("greeting Alice")
+	})
+	tf.RunTest("MapError - context applied on the error track",
+		r.IsError() && r.ErrorInfo().Message == "greeting Alice: connection refused")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorCodes tests the machine-readable Code field.
+func TestDomainErrorCodes(t *testing.T) {
+	tf := test.New("Domain.Error.Codes")
+
+	// ========================================================================
+	// Test: Plain constructors leave Code empty and render unchanged
+	// ========================================================================
+
+	plain := domerr.NewValidationError("bad input")
+	tf.RunTest("Plain constructor - code empty", plain.Code == "")
+	tf.RunTest("Plain constructor - rendering unchanged",
+		plain.Error() == "ValidationError: bad input")
+
+	// ========================================================================
+	// Test: WithCode constructors set kind, code and message
+	// ========================================================================
+
+	coded := domerr.NewValidationErrorWithCode("ERR_NAME_EMPTY", "Person name cannot be empty")
+	tf.RunTest("WithCode - kind set", coded.Kind == domerr.ValidationError)
+	tf.RunTest("WithCode - code set", coded.Code == "ERR_NAME_EMPTY")
+	tf.RunTest("WithCode - rendering appends the code",
+		coded.Error() == "ValidationError: Person name cannot be empty [ERR_NAME_EMPTY]")
+
+	tf.RunTest("WithCode - infrastructure variant",
+		domerr.NewInfrastructureErrorWithCode("ERR_IO", "disk full").Kind == domerr.InfrastructureError)
+	tf.RunTest("WithCode - not-found variant",
+		domerr.NewNotFoundErrorWithCode("ERR_MISSING", "gone").Kind == domerr.NotFoundError)
+	tf.RunTest("WithCode - timeout variant",
+		domerr.NewTimeoutErrorWithCode("ERR_SLOW", "took too long").Kind == domerr.TimeoutError)
+
+	// ========================================================================
+	// Test: The code rides along the railway and survives enrichment
+	// ========================================================================
+
+	r := domerr.Err[int](coded).MapError(func(e domerr.ErrorType) domerr.ErrorType {
+		return e.WithContext("creating person")
+	})
+	tf.RunTest("Railway - code preserved through WithContext",
+		r.IsError() && r.ErrorInfo().Code == "ERR_NAME_EMPTY")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainErrorJSON tests the stable JSON wire shape for ErrorType.
+func TestDomainErrorJSON(t *testing.T) {
+	tf := test.New("Domain.Error.JSON")
+
+	// ========================================================================
+	// Test: Kind serializes as its string form, never the iota value
+	// ========================================================================
+
+	data, err := json.Marshal(domerr.NewValidationError("bad input"))
+	tf.RunTest("Marshal - no error", err == nil)
+	tf.RunTest("Marshal - kind rendered as string",
+		err == nil && string(data) == `{"kind":"ValidationError","message":"bad input"}`)
+
+	coded, err := json.Marshal(domerr.NewNotFoundErrorWithCode("ERR_MISSING", "gone"))
+	tf.RunTest("Marshal - code included when set",
+		err == nil && string(coded) == `{"kind":"NotFoundError","message":"gone","code":"ERR_MISSING"}`)
+
+	// ========================================================================
+	// Test: Every kind round-trips
+	// ========================================================================
+
+	for _, original := range []domerr.ErrorType{
+		domerr.NewValidationError("v"),
+		domerr.NewInfrastructureError("i"),
+		domerr.NewNotFoundError("n"),
+		domerr.NewTimeoutError("t"),
+	} {
+		encoded, marshalErr := json.Marshal(original)
+		var decoded domerr.ErrorType
+		unmarshalErr := json.Unmarshal(encoded, &decoded)
+		tf.RunTest("Round trip - "+original.Kind.String(),
+			marshalErr == nil && unmarshalErr == nil && decoded == original)
+	}
+
+	// ========================================================================
+	// Test: Unknown kinds are rejected loudly
+	// ========================================================================
+
+	var decoded domerr.ErrorType
+	unknownErr := json.Unmarshal([]byte(`{"kind":"FutureError","message":"x"}`), &decoded)
+	tf.RunTest("Unmarshal - unknown kind errors", unknownErr != nil)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: metrics
+// Description: Lightweight Result outcome counting
+
+// Package metrics provides lightweight, allocation-free helpers for counting
+// Result outcomes in pipelines without bespoke callback plumbing.
+//
+// Architecture Notes:
+//   - Part of the DOMAIN layer (pure, standard library only)
+//   - Counters are safe for concurrent use (atomic operations)
+//   - Designed to compose with Result.OnComplete
+package metrics
+
+import (
+	"sync/atomic"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Counter counts Ok and Err outcomes of Result[T] values atomically.
+//
+// The Count method has the exact shape OnComplete expects, so a counter
+// drops into a pipeline as a method value:
+//
+//	var counter metrics.Counter[string]
+//	result := operation().OnComplete(counter.Count)
+//
+// The zero value is ready to use. Safe for concurrent use.
+type Counter[T any] struct {
+	ok  atomic.Int64
+	err atomic.Int64
+}
+
+// NewCounter creates a Counter. Provided for symmetry with other
+// constructors; the zero value is equally usable.
+func NewCounter[T any]() *Counter[T] {
+	return &Counter[T]{}
+}
+
+// Count records the outcome of r, incrementing the success or failure
+// counter atomically.
+func (c *Counter[T]) Count(r domerr.Result[T]) {
+	if r.IsOk() {
+		c.ok.Add(1)
+	} else {
+		c.err.Add(1)
+	}
+}
+
+// CounterSnapshot is a point-in-time view of a Counter's totals.
+type CounterSnapshot struct {
+	Ok    int64
+	Err   int64
+	Total int64
+}
+
+// Snapshot returns the current totals. Under concurrent counting the Ok and
+// Err fields are each atomically read; Total is their sum at read time.
+func (c *Counter[T]) Snapshot() CounterSnapshot {
+	ok := c.ok.Load()
+	err := c.err.Load()
+	return CounterSnapshot{
+		Ok:    ok,
+		Err:   err,
+		Total: ok + err,
+	}
+}
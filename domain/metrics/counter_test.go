@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package metrics_test
+
+import (
+	"sync"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/metrics"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainMetricsCounter tests outcome counting across Ok and Err results.
+func TestDomainMetricsCounter(t *testing.T) {
+	tf := test.New("Domain.Metrics.Counter")
+
+	// ========================================================================
+	// Test: Zero value counter starts at zero
+	// ========================================================================
+
+	var counter metrics.Counter[int]
+	snap := counter.Snapshot()
+	tf.RunTest("Zero value - all totals zero",
+		snap.Ok == 0 && snap.Err == 0 && snap.Total == 0)
+
+	// ========================================================================
+	// Test: Counting many Ok and Err results yields the expected snapshot
+	// ========================================================================
+
+	for i := 0; i < 70; i++ {
+		counter.Count(domerr.Ok(i))
+	}
+	for i := 0; i < 30; i++ {
+		counter.Count(domerr.Err[int](domerr.NewValidationError("bad")))
+	}
+	snap = counter.Snapshot()
+	tf.RunTest("Mixed counting - Ok total", snap.Ok == 70)
+	tf.RunTest("Mixed counting - Err total", snap.Err == 30)
+	tf.RunTest("Mixed counting - grand total", snap.Total == 100)
+
+	// ========================================================================
+	// Test: Counter drops into a pipeline via OnComplete
+	// ========================================================================
+
+	pipeline := metrics.NewCounter[string]()
+	domerr.Ok("hello").OnComplete(pipeline.Count)
+	domerr.Err[string](domerr.NewInfrastructureError("down")).OnComplete(pipeline.Count)
+	pipelineSnap := pipeline.Snapshot()
+	tf.RunTest("OnComplete - both outcomes counted",
+		pipelineSnap.Ok == 1 && pipelineSnap.Err == 1 && pipelineSnap.Total == 2)
+
+	// ========================================================================
+	// Test: Concurrent counting loses no increments (run with -race)
+	// ========================================================================
+
+	concurrent := metrics.NewCounter[int]()
+	var wg sync.WaitGroup
+	const goroutines = 8
+	const perGoroutine = 1000
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				if i%2 == 0 {
+					concurrent.Count(domerr.Ok(i))
+				} else {
+					concurrent.Count(domerr.Err[int](domerr.NewValidationError("bad")))
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	concurrentSnap := concurrent.Snapshot()
+	tf.RunTest("Concurrent - Ok half counted",
+		concurrentSnap.Ok == goroutines*perGoroutine/2)
+	tf.RunTest("Concurrent - Err half counted",
+		concurrentSnap.Err == goroutines*perGoroutine/2)
+	tf.RunTest("Concurrent - grand total exact",
+		concurrentSnap.Total == goroutines*perGoroutine)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
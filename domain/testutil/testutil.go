@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// Package testutil provides assertion helpers that bridge the domain Result
+// monad with the standard testing package.
+//
+// These helpers complement the Ada-style framework in domain/test: where the
+// framework reports [PASS]/[FAIL] lines, these helpers integrate with plain
+// *testing.T tests, failing fast via t.Fatalf when a Result is in the wrong
+// state and returning the contained value otherwise.
+//
+// Architecture Notes:
+//   - Part of the DOMAIN layer (stdlib testing only, ZERO external dependencies)
+//   - Accepts testing.TB so both *testing.T and *testing.B work (and fakes in
+//     this package's own tests)
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/domain/testutil"
+//
+//	func TestSomething(t *testing.T) {
+//	    person := testutil.AssertOk(t, valueobject.CreatePerson("Alice"))
+//	    // test continues only if the Result was Ok
+//	    _ = person
+//	}
+package testutil
+
+import (
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// AssertOk fails the test via t.Fatalf if r is an error, otherwise returns
+// the contained value.
+//
+// The failure message includes the error's kind and message so the cause is
+// visible directly in the test output.
+func AssertOk[T any](t testing.TB, r domerr.Result[T]) T {
+	t.Helper()
+	if r.IsError() {
+		t.Fatalf("expected Ok Result, got error: %s", r.ErrorInfo().Error())
+		var zero T
+		return zero // unreachable with a real *testing.T; fakes may continue
+	}
+	return r.Value()
+}
+
+// AssertErr fails the test via t.Fatalf if r is Ok, otherwise returns the
+// contained ErrorType for further assertions (kind, message).
+func AssertErr[T any](t testing.TB, r domerr.Result[T]) domerr.ErrorType {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("expected error Result, got Ok with value: %v", r.Value())
+		return domerr.ErrorType{} // unreachable with a real *testing.T
+	}
+	return r.ErrorInfo()
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package testutil_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/testutil"
+)
+
+// fakeTB records Fatalf calls instead of aborting, letting us verify the
+// failure path of the assertion helpers without failing this test.
+type fakeTB struct {
+	testing.TB
+	fatalfCalled bool
+	message      string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Fatalf(format string, args ...any) {
+	f.fatalfCalled = true
+	f.message = fmt.Sprintf(format, args...)
+}
+
+// TestDomainTestutilAssertions tests AssertOk and AssertErr.
+func TestDomainTestutilAssertions(t *testing.T) {
+	tf := test.New("Domain.Testutil.Assertions")
+
+	// ========================================================================
+	// Test: AssertOk with Ok Result returns value, no failure
+	// ========================================================================
+
+	fake1 := &fakeTB{}
+	value := testutil.AssertOk(fake1, domerr.Ok(42))
+	tf.RunTest("AssertOk on Ok - returns contained value", value == 42)
+	tf.RunTest("AssertOk on Ok - does not call Fatalf", !fake1.fatalfCalled)
+
+	// ========================================================================
+	// Test: AssertOk with Err Result fails via Fatalf
+	// ========================================================================
+
+	fake2 := &fakeTB{}
+	testutil.AssertOk(fake2, domerr.Err[int](domerr.NewValidationError("bad input")))
+	tf.RunTest("AssertOk on Err - calls Fatalf", fake2.fatalfCalled)
+	tf.RunTest("AssertOk on Err - message contains error text",
+		strings.Contains(fake2.message, "bad input"))
+
+	// ========================================================================
+	// Test: AssertErr with Err Result returns ErrorType, no failure
+	// ========================================================================
+
+	fake3 := &fakeTB{}
+	info := testutil.AssertErr(fake3, domerr.Err[string](domerr.NewInfrastructureError("disk full")))
+	tf.RunTest("AssertErr on Err - does not call Fatalf", !fake3.fatalfCalled)
+	tf.RunTest("AssertErr on Err - returns correct kind",
+		info.Kind == domerr.InfrastructureError)
+	tf.RunTest("AssertErr on Err - returns correct message",
+		info.Message == "disk full")
+
+	// ========================================================================
+	// Test: AssertErr with Ok Result fails via Fatalf
+	// ========================================================================
+
+	fake4 := &fakeTB{}
+	testutil.AssertErr(fake4, domerr.Ok("unexpected"))
+	tf.RunTest("AssertErr on Ok - calls Fatalf", fake4.fatalfCalled)
+
+	// ========================================================================
+	// Test: AssertOk works with a real *testing.T on the success path
+	// ========================================================================
+
+	real := testutil.AssertOk(t, domerr.Ok("hello"))
+	tf.RunTest("AssertOk with real T - returns value", real == "hello")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
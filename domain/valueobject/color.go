@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Color value object parsed from hex notation
+
+package valueobject
+
+import (
+	"fmt"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Color represents an RGB color as an immutable value object.
+//
+// Colors are created from CSS-style hex notation via ParseHexColor and
+// broaden the value-object catalog for graphical greeter variants.
+//
+// Design Pattern: Value Object
+//   - Immutable after creation
+//   - Validation enforced via ParseHexColor smart constructor
+//   - Defined by its channel values, not identity
+//
+// Contract:
+//   - Each channel is in [0, 255] (guaranteed by uint8)
+//   - Use ParseHexColor() to instantiate, not struct literal
+type Color struct {
+	r, g, b uint8
+}
+
+// ParseHexColor creates a Color from hex notation with validation.
+//
+// Accepted formats:
+//   - "#RRGGBB" - six hex digits, two per channel
+//   - "#RGB"    - three hex digits, each expanded by doubling ("#1af" -> "#11aaff")
+//
+// Validation rules:
+//  1. Input must start with '#'
+//  2. Exactly 3 or 6 hex digits must follow
+//  3. Every digit must be a valid hex digit (case-insensitive)
+//
+// Returns:
+//   - domerr.Result[Color] - Ok if valid, Err(ValidationError) otherwise
+func ParseHexColor(s string) domerr.Result[Color] {
+	// Validation 1: Check for the leading '#'
+	if len(s) == 0 || s[0] != '#' {
+		return domerr.Err[Color](domerr.NewValidationError(
+			"Color must start with '#'"))
+	}
+
+	digits := s[1:]
+
+	// Validation 2 + parse: accept exactly 3 or 6 hex digits
+	switch len(digits) {
+	case 6:
+		r, okR := parseHexByte(digits[0], digits[1])
+		g, okG := parseHexByte(digits[2], digits[3])
+		b, okB := parseHexByte(digits[4], digits[5])
+		if !okR || !okG || !okB {
+			return domerr.Err[Color](domerr.NewValidationError(
+				fmt.Sprintf("Color %q contains invalid hex digits", s)))
+		}
+		return domerr.Ok(Color{r: r, g: g, b: b})
+	case 3:
+		// Shorthand: each digit is doubled ("#1af" is "#11aaff")
+		r, okR := parseHexByte(digits[0], digits[0])
+		g, okG := parseHexByte(digits[1], digits[1])
+		b, okB := parseHexByte(digits[2], digits[2])
+		if !okR || !okG || !okB {
+			return domerr.Err[Color](domerr.NewValidationError(
+				fmt.Sprintf("Color %q contains invalid hex digits", s)))
+		}
+		return domerr.Ok(Color{r: r, g: g, b: b})
+	default:
+		return domerr.Err[Color](domerr.NewValidationError(
+			"Color must have exactly 3 or 6 hex digits after '#'"))
+	}
+}
+
+// parseHexByte combines two hex digit characters into one byte value.
+// The second return value reports whether both characters were valid.
+func parseHexByte(hi, lo byte) (uint8, bool) {
+	h, okH := hexDigitValue(hi)
+	l, okL := hexDigitValue(lo)
+	return h<<4 | l, okH && okL
+}
+
+// hexDigitValue converts a single hex digit character to its numeric value.
+func hexDigitValue(c byte) (uint8, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	default:
+		return 0, false
+	}
+}
+
+// R returns the red channel value (0-255).
+func (c Color) R() uint8 {
+	return c.r
+}
+
+// G returns the green channel value (0-255).
+func (c Color) G() uint8 {
+	return c.g
+}
+
+// B returns the blue channel value (0-255).
+func (c Color) B() uint8 {
+	return c.b
+}
+
+// Hex returns the canonical "#rrggbb" representation (lowercase, six digits).
+//
+// Contract:
+//   - Post: Result always has length 7 and starts with '#'
+//   - Post: ParseHexColor(c.Hex()) returns an equal Color
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.r, c.g, c.b)
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueObjectColor tests the Color value object.
+func TestDomainValueObjectColor(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Color")
+
+	// ========================================================================
+	// Test: ParseHexColor with valid 6-digit notation
+	// ========================================================================
+
+	r1 := valueobject.ParseHexColor("#1A2B3C")
+	tf.RunTest("ParseHexColor 6-digit - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		color := r1.Value()
+		tf.RunTest("ParseHexColor 6-digit - R channel correct", color.R() == 0x1A)
+		tf.RunTest("ParseHexColor 6-digit - G channel correct", color.G() == 0x2B)
+		tf.RunTest("ParseHexColor 6-digit - B channel correct", color.B() == 0x3C)
+		tf.RunTest("ParseHexColor 6-digit - Hex round-trips lowercase",
+			color.Hex() == "#1a2b3c")
+	}
+
+	// ========================================================================
+	// Test: ParseHexColor with valid 3-digit shorthand
+	// ========================================================================
+
+	r2 := valueobject.ParseHexColor("#1af")
+	tf.RunTest("ParseHexColor 3-digit - IsOk returns true", r2.IsOk())
+	if r2.IsOk() {
+		color := r2.Value()
+		tf.RunTest("ParseHexColor 3-digit - R expanded by doubling", color.R() == 0x11)
+		tf.RunTest("ParseHexColor 3-digit - G expanded by doubling", color.G() == 0xAA)
+		tf.RunTest("ParseHexColor 3-digit - B expanded by doubling", color.B() == 0xFF)
+		tf.RunTest("ParseHexColor 3-digit - Hex returns full form",
+			color.Hex() == "#11aaff")
+	}
+
+	// ========================================================================
+	// Test: Missing '#' prefix (validation error)
+	// ========================================================================
+
+	r3 := valueobject.ParseHexColor("1a2b3c")
+	tf.RunTest("ParseHexColor missing # - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("ParseHexColor missing # - kind is ValidationError",
+			r3.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: Invalid hex digits (validation error)
+	// ========================================================================
+
+	r4 := valueobject.ParseHexColor("#1a2b3g")
+	tf.RunTest("ParseHexColor invalid digit - IsError returns true", r4.IsError())
+	if r4.IsError() {
+		tf.RunTest("ParseHexColor invalid digit - kind is ValidationError",
+			r4.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: Wrong digit counts (validation error)
+	// ========================================================================
+
+	for _, bad := range []string{"#", "#1a", "#1a2b", "#1a2b3c4d"} {
+		rBad := valueobject.ParseHexColor(bad)
+		tf.RunTest("ParseHexColor wrong length '"+bad+"' - IsError returns true",
+			rBad.IsError())
+	}
+
+	// ========================================================================
+	// Test: Boundary channels (black and white)
+	// ========================================================================
+
+	r5 := valueobject.ParseHexColor("#000000")
+	tf.RunTest("ParseHexColor black - IsOk returns true", r5.IsOk())
+	if r5.IsOk() {
+		color := r5.Value()
+		tf.RunTest("ParseHexColor black - all channels zero",
+			color.R() == 0 && color.G() == 0 && color.B() == 0)
+	}
+
+	r6 := valueobject.ParseHexColor("#FFFFFF")
+	tf.RunTest("ParseHexColor white - IsOk returns true", r6.IsOk())
+	if r6.IsOk() {
+		color := r6.Value()
+		tf.RunTest("ParseHexColor white - all channels max",
+			color.R() == 255 && color.G() == 255 && color.B() == 255)
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Email address value object for the greeter domain
+
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MaxEmailLength is the maximum allowed length for an email address,
+// per RFC 5321's practical path limit.
+const MaxEmailLength = 254
+
+// Email represents an email address as an immutable value object.
+//
+// Validation is deliberately structural rather than a full RFC 5322 parse:
+// the address must have exactly one '@' with a non-empty local part and a
+// domain containing at least one dot. Deliverability is an infrastructure
+// concern, not a domain invariant.
+//
+// Contract:
+//   - Value always contains exactly one '@' (enforced by CreateEmail)
+//   - Use CreateEmail() to instantiate, not struct literal
+type Email struct {
+	value string
+}
+
+// CreateEmail creates a new Email value object with validation.
+//
+// Validation rules:
+//  1. Address must not be empty
+//  2. Address must not exceed MaxEmailLength
+//  3. Address must contain exactly one '@'
+//  4. Local part and domain must be non-empty; domain must contain a dot
+//
+// Returns:
+//   - domerr.Result[Email] - Ok if valid, Err if validation fails
+func CreateEmail(address string) domerr.Result[Email] {
+	if len(address) == 0 {
+		return domerr.Err[Email](domerr.NewValidationError("Email address cannot be empty"))
+	}
+
+	if len(address) > MaxEmailLength {
+		return domerr.Err[Email](domerr.NewValidationError(
+			fmt.Sprintf("Email address exceeds maximum length of %d characters", MaxEmailLength)))
+	}
+
+	local, domain, found := strings.Cut(address, "@")
+	if !found || strings.Contains(domain, "@") {
+		return domerr.Err[Email](domerr.NewValidationError(
+			"Email address must contain exactly one '@'"))
+	}
+	if local == "" || domain == "" || !strings.Contains(domain, ".") {
+		return domerr.Err[Email](domerr.NewValidationError(
+			"Email address must have a local part and a dotted domain"))
+	}
+
+	return domerr.Ok(Email{value: address})
+}
+
+// GetAddress returns the string representation of the email address.
+func (e Email) GetAddress() string {
+	return e.value
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueObjectEmail tests the Email value object.
+func TestDomainValueObjectEmail(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Email")
+
+	// ========================================================================
+	// Test: Structurally valid addresses are accepted verbatim
+	// ========================================================================
+
+	r1 := valueobject.CreateEmail("alice@example.com")
+	tf.RunTest("Valid address - accepted", r1.IsOk())
+	tf.RunTest("Valid address - preserved",
+		r1.IsOk() && r1.Value().GetAddress() == "alice@example.com")
+	tf.RunTest("Subdomain - accepted", valueobject.CreateEmail("bob@mail.example.co.uk").IsOk())
+
+	// ========================================================================
+	// Test: Structural problems are rejected
+	// ========================================================================
+
+	tf.RunTest("Empty - rejected", valueobject.CreateEmail("").IsError())
+	tf.RunTest("No at sign - rejected", valueobject.CreateEmail("alice.example.com").IsError())
+	tf.RunTest("Two at signs - rejected", valueobject.CreateEmail("a@b@example.com").IsError())
+	tf.RunTest("Empty local part - rejected", valueobject.CreateEmail("@example.com").IsError())
+	tf.RunTest("Dotless domain - rejected", valueobject.CreateEmail("alice@localhost").IsError())
+
+	// ========================================================================
+	// Test: The length bound is enforced
+	// ========================================================================
+
+	long := strings.Repeat("a", valueobject.MaxEmailLength) + "@example.com"
+	tf.RunTest("Over max length - rejected", valueobject.CreateEmail(long).IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Greeting value object for the greeter domain
+
+package valueobject
+
+import (
+	"strings"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Greeting represents a validated greeting message as an immutable value
+// object.
+//
+// Design Pattern: Value Object
+//   - Immutable after creation
+//   - Produced only via Person.Greet, so the invariant cannot be bypassed
+//   - Strengthens typing: writers can accept a Greeting instead of any string
+//
+// Contract:
+//   - Text always starts with "Hello, " and ends with "!" (the greeting
+//     invariant, enforced at construction)
+type Greeting struct {
+	text string
+}
+
+// GreetingPrefix and GreetingTerminator are the structural markers of the
+// default greeting produced by Person.GreetingMessage. Exported so tests
+// and callers can assert the invariant without re-encoding the literals.
+const (
+	GreetingPrefix     = "Hello, "
+	GreetingTerminator = "!"
+)
+
+// HasGreetingPrefix reports whether s starts with the standard greeting
+// prefix "Hello, ".
+func HasGreetingPrefix(s string) bool {
+	return strings.HasPrefix(s, GreetingPrefix)
+}
+
+// HasGreetingTerminator reports whether s ends with the greeting's
+// terminal "!".
+func HasGreetingTerminator(s string) bool {
+	return strings.HasSuffix(s, GreetingTerminator)
+}
+
+// IsWellFormedGreeting reports whether s has the complete structure of a
+// default greeting: the "Hello, " prefix, the "!" terminator, and a
+// non-empty name between them. This is the invariant Greet enforces and
+// the one tests should assert instead of duplicating prefix/suffix checks.
+func IsWellFormedGreeting(s string) bool {
+	return HasGreetingPrefix(s) &&
+		HasGreetingTerminator(s) &&
+		len(s) > len(GreetingPrefix)+len(GreetingTerminator)
+}
+
+// Greet generates the validated Greeting for this person.
+//
+// The greeting invariant ("Hello, <name>!") is checked at construction so
+// downstream consumers can rely on it by type rather than by convention.
+// With a valid Person the invariant always holds; the Result shape keeps
+// the construction composable with the rest of the railway.
+//
+// Example:
+//
+//	greetingResult := person.Greet()
+//	if greetingResult.IsOk() {
+//	    text := greetingResult.Value().Text()
+//	}
+func (p Person) Greet() domerr.Result[Greeting] {
+	text := p.GreetingMessage()
+	if !IsWellFormedGreeting(text) {
+		return domerr.Err[Greeting](domerr.NewValidationError(
+			"greeting violates the \"Hello, <name>!\" invariant"))
+	}
+	return domerr.Ok(Greeting{text: text})
+}
+
+// Text returns the greeting message.
+//
+// Contract:
+//   - Post: Result starts with "Hello, " and ends with "!"
+func (g Greeting) Text() string {
+	return g.text
+}
+
+// Length returns the length of the greeting message in bytes.
+func (g Greeting) Length() int {
+	return len(g.text)
+}
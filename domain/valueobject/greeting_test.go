@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueobjectGreeting tests the Greeting value object.
+func TestDomainValueobjectGreeting(t *testing.T) {
+	tf := test.New("Domain.Valueobject.Greeting")
+
+	// ========================================================================
+	// Test: Greet produces the expected text for a valid person
+	// ========================================================================
+
+	personResult := valueobject.CreatePerson("Alice")
+	tf.RunTest("Setup - person created", personResult.IsOk())
+	if personResult.IsOk() {
+		greetingResult := personResult.Value().Greet()
+		tf.RunTest("Greet - IsOk returns true", greetingResult.IsOk())
+		if greetingResult.IsOk() {
+			greeting := greetingResult.Value()
+			tf.RunTest("Greet - text matches expected format",
+				greeting.Text() == "Hello, Alice!")
+			tf.RunTest("Greet - length matches text",
+				greeting.Length() == len("Hello, Alice!"))
+		}
+	}
+
+	// ========================================================================
+	// Test: The greeting invariant holds for varied names
+	// ========================================================================
+
+	names := []string{"Bob", "María José", "世界", "  spaced  "}
+	invariantHolds := true
+	for _, name := range names {
+		pr := valueobject.CreatePerson(name)
+		if pr.IsError() {
+			invariantHolds = false
+			continue
+		}
+		gr := pr.Value().Greet()
+		if gr.IsError() {
+			invariantHolds = false
+			continue
+		}
+		text := gr.Value().Text()
+		invariantHolds = invariantHolds &&
+			strings.HasPrefix(text, "Hello, ") &&
+			strings.HasSuffix(text, "!")
+	}
+	tf.RunTest("Invariant - holds for varied names", invariantHolds)
+
+	// ========================================================================
+	// Test: Unicode greeting length is byte length (documented contract)
+	// ========================================================================
+
+	pr := valueobject.CreatePerson("世界")
+	if pr.IsOk() {
+		gr := pr.Value().Greet()
+		tf.RunTest("Unicode - length is byte length",
+			gr.IsOk() && gr.Value().Length() == len("Hello, 世界!"))
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueobjectGreetingPredicates tests the greeting invariant helpers.
+func TestDomainValueobjectGreetingPredicates(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Greeting.Predicates")
+
+	// ========================================================================
+	// Test: Valid greetings satisfy every predicate
+	// ========================================================================
+
+	tf.RunTest("Valid - prefix", valueobject.HasGreetingPrefix("Hello, Alice!"))
+	tf.RunTest("Valid - terminator", valueobject.HasGreetingTerminator("Hello, Alice!"))
+	tf.RunTest("Valid - well-formed", valueobject.IsWellFormedGreeting("Hello, Alice!"))
+	tf.RunTest("Valid - single-rune name", valueobject.IsWellFormedGreeting("Hello, A!"))
+
+	// ========================================================================
+	// Test: Malformed greetings are rejected
+	// ========================================================================
+
+	tf.RunTest("Malformed - wrong prefix",
+		!valueobject.IsWellFormedGreeting("Goodbye, Alice!"))
+	tf.RunTest("Malformed - missing terminator",
+		!valueobject.IsWellFormedGreeting("Hello, Alice"))
+	tf.RunTest("Malformed - empty name",
+		!valueobject.IsWellFormedGreeting("Hello, !"))
+	tf.RunTest("Malformed - empty string",
+		!valueobject.IsWellFormedGreeting(""))
+	tf.RunTest("Malformed - prefix alone fails only the whole predicate",
+		valueobject.HasGreetingPrefix("Hello, ") &&
+			!valueobject.IsWellFormedGreeting("Hello, "))
+
+	// ========================================================================
+	// Test: The exported markers match GreetingMessage output
+	// ========================================================================
+
+	greeting := valueobject.CreatePerson("Bob").Value().GreetingMessage()
+	tf.RunTest("Markers - assemble the default greeting",
+		greeting == valueobject.GreetingPrefix+"Bob"+valueobject.GreetingTerminator)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
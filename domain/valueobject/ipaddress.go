@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: IPAddress value object wrapping stdlib parsing
+
+package valueobject
+
+import (
+	"fmt"
+	"net"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// IPAddress represents a validated IP address as an immutable value object.
+//
+// This value object demonstrates wrapping stdlib parsing (net.ParseIP) in
+// the Result pattern for network-oriented consumers.
+//
+// Design Pattern: Value Object
+//   - Immutable after creation
+//   - Validation enforced via ParseIP smart constructor
+//   - Defined by its address, not identity
+//
+// Contract:
+//   - The wrapped address is always a valid IPv4 or IPv6 address
+//   - Use ParseIP() to instantiate, not struct literal
+type IPAddress struct {
+	ip net.IP
+}
+
+// ParseIP creates an IPAddress from its textual representation.
+//
+// Accepts both IPv4 ("192.168.0.1") and IPv6 ("2001:db8::1") notation, as
+// understood by net.ParseIP.
+//
+// Returns:
+//   - domerr.Result[IPAddress] - Ok if valid, Err(ValidationError) otherwise
+func ParseIP(s string) domerr.Result[IPAddress] {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return domerr.Err[IPAddress](domerr.NewValidationError(
+			fmt.Sprintf("invalid IP address %q", s)))
+	}
+	return domerr.Ok(IPAddress{ip: ip})
+}
+
+// IsV4 returns true if the address is IPv4.
+func (a IPAddress) IsV4() bool {
+	return a.ip.To4() != nil
+}
+
+// IsV6 returns true if the address is IPv6 (and not an IPv4 address).
+func (a IPAddress) IsV6() bool {
+	return a.ip.To4() == nil && a.ip.To16() != nil
+}
+
+// String returns the canonical textual representation of the address.
+func (a IPAddress) String() string {
+	return a.ip.String()
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueObjectIPAddress tests the IPAddress value object.
+func TestDomainValueObjectIPAddress(t *testing.T) {
+	tf := test.New("Domain.ValueObject.IPAddress")
+
+	// ========================================================================
+	// Test: ParseIP with valid IPv4
+	// ========================================================================
+
+	r1 := valueobject.ParseIP("192.168.0.1")
+	tf.RunTest("ParseIP IPv4 - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		addr := r1.Value()
+		tf.RunTest("ParseIP IPv4 - IsV4 returns true", addr.IsV4())
+		tf.RunTest("ParseIP IPv4 - IsV6 returns false", !addr.IsV6())
+		tf.RunTest("ParseIP IPv4 - String round-trips",
+			addr.String() == "192.168.0.1")
+	}
+
+	// ========================================================================
+	// Test: ParseIP with valid IPv6
+	// ========================================================================
+
+	r2 := valueobject.ParseIP("2001:db8::1")
+	tf.RunTest("ParseIP IPv6 - IsOk returns true", r2.IsOk())
+	if r2.IsOk() {
+		addr := r2.Value()
+		tf.RunTest("ParseIP IPv6 - IsV6 returns true", addr.IsV6())
+		tf.RunTest("ParseIP IPv6 - IsV4 returns false", !addr.IsV4())
+		tf.RunTest("ParseIP IPv6 - String round-trips",
+			addr.String() == "2001:db8::1")
+	}
+
+	// ========================================================================
+	// Test: ParseIP with invalid input (validation error)
+	// ========================================================================
+
+	r3 := valueobject.ParseIP("not-an-ip")
+	tf.RunTest("ParseIP invalid - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		info := r3.ErrorInfo()
+		tf.RunTest("ParseIP invalid - kind is ValidationError",
+			info.Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: ParseIP with empty string (validation error)
+	// ========================================================================
+
+	r4 := valueobject.ParseIP("")
+	tf.RunTest("ParseIP empty - IsError returns true", r4.IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Reusable non-empty bounded string building block
+
+package valueobject
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// NonEmptyString is a reusable building block for string-backed value
+// objects: a string guaranteed non-empty and within a rune-count bound.
+// Value objects like Person wrap it (or mirror its checks) instead of
+// repeating the empty/length validation pattern.
+//
+// Contract:
+//   - Value is never empty (enforced by NewNonEmptyString)
+//   - Rune count never exceeds the bound given at construction
+//   - Use NewNonEmptyString() to instantiate, not struct literal
+type NonEmptyString struct {
+	value string
+}
+
+// NewNonEmptyString creates a NonEmptyString validated against maxRunes.
+// Length is counted in runes, so multibyte characters count once.
+//
+// The error messages speak of "value"; wrapping value objects reword them
+// in their own terms via MapError (see CreatePersonRunes).
+//
+// Returns:
+//   - domerr.Result[NonEmptyString] - Ok if valid, Err if validation fails
+func NewNonEmptyString(s string, maxRunes int) domerr.Result[NonEmptyString] {
+	if len(s) == 0 {
+		return domerr.Err[NonEmptyString](domerr.NewValidationError("value cannot be empty"))
+	}
+
+	if utf8.RuneCountInString(s) > maxRunes {
+		return domerr.Err[NonEmptyString](domerr.NewValidationError(
+			fmt.Sprintf("value exceeds maximum length of %d characters", maxRunes)))
+	}
+
+	return domerr.Ok(NonEmptyString{value: s})
+}
+
+// String returns the underlying string.
+func (n NonEmptyString) String() string {
+	return n.value
+}
+
+// RuneCount returns the length of the underlying string in runes.
+func (n NonEmptyString) RuneCount() int {
+	return utf8.RuneCountInString(n.value)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueObjectNonEmptyString tests the reusable string block.
+func TestDomainValueObjectNonEmptyString(t *testing.T) {
+	tf := test.New("Domain.ValueObject.NonEmptyString")
+
+	// ========================================================================
+	// Test: Valid strings are stored verbatim
+	// ========================================================================
+
+	r1 := valueobject.NewNonEmptyString("hello", 10)
+	tf.RunTest("Valid - accepted", r1.IsOk())
+	tf.RunTest("Valid - preserved", r1.IsOk() && r1.Value().String() == "hello")
+	tf.RunTest("Valid - rune count reported", r1.IsOk() && r1.Value().RuneCount() == 5)
+
+	// ========================================================================
+	// Test: Empty input is rejected
+	// ========================================================================
+
+	r2 := valueobject.NewNonEmptyString("", 10)
+	tf.RunTest("Empty - rejected", r2.IsError())
+	tf.RunTest("Empty - message mentions empty",
+		r2.IsError() && strings.Contains(r2.ErrorInfo().Message, "empty"))
+
+	// ========================================================================
+	// Test: The bound counts runes, not bytes
+	// ========================================================================
+
+	tf.RunTest("At rune bound - accepted",
+		valueobject.NewNonEmptyString(strings.Repeat("👋", 10), 10).IsOk())
+	tf.RunTest("Over rune bound - rejected",
+		valueobject.NewNonEmptyString(strings.Repeat("👋", 11), 10).IsError())
+
+	// ========================================================================
+	// Test: Person built on NonEmptyString behaves exactly as before
+	// ========================================================================
+
+	tf.RunTest("Person - valid name unchanged",
+		valueobject.CreatePersonRunes("Alice").IsOk() &&
+			valueobject.CreatePersonRunes("Alice").Value().GetName() == "Alice")
+	r3 := valueobject.CreatePersonRunes("")
+	tf.RunTest("Person - empty message reworded in Person terms",
+		r3.IsError() && r3.ErrorInfo().Message == "Person name cannot be empty")
+	r4 := valueobject.CreatePersonRunes(strings.Repeat("a", valueobject.MaxNameLength+1))
+	tf.RunTest("Person - length message reworded in Person terms",
+		r4.IsError() && strings.Contains(r4.ErrorInfo().Message, "Person name exceeds maximum length"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,50 @@
+// ===========================================================================
+// okor.go
+// ===========================================================================
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// SPDX-License-Identifier: BSD-3-Clause
+//
+// Purpose:
+//   Option -> Result bridges. Converts the "value may be absent" shape into
+//   the "value or error" shape so Option-producing lookups can join a
+//   railway-oriented Result chain.
+//
+// Design Pattern:
+//   - OkOr: eager error (use when the error is a cheap literal)
+//   - OkOrElse: lazy error (use when constructing the error costs something)
+// ===========================================================================
+
+package valueobject
+
+import (
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// OkOr converts an Option to a Result, using the given error for None.
+// The error is eagerly evaluated; prefer OkOrElse when it is expensive
+// to construct.
+//
+// Example:
+//
+//	result := OkOr(findUser(id), domerr.NewValidationError("user not found"))
+func OkOr[T any](o Option[T], err domerr.ErrorType) domerr.Result[T] {
+	if o.isSome {
+		return domerr.Ok(o.value)
+	}
+	return domerr.Err[T](err)
+}
+
+// OkOrElse converts an Option to a Result, computing the error lazily via
+// errFn only when the Option is None.
+//
+// Example:
+//
+//	result := OkOrElse(findUser(id), func() domerr.ErrorType {
+//	    return domerr.NewValidationError(describeMissingUser(id))
+//	})
+func OkOrElse[T any](o Option[T], errFn func() domerr.ErrorType) domerr.Result[T] {
+	if o.isSome {
+		return domerr.Ok(o.value)
+	}
+	return domerr.Err[T](errFn())
+}
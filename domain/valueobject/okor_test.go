@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueobjectOkOr tests the Option -> Result bridges.
+func TestDomainValueobjectOkOr(t *testing.T) {
+	tf := test.New("Domain.Valueobject.OkOr")
+
+	// ========================================================================
+	// Test: OkOr on Some passes the value through
+	// ========================================================================
+
+	r1 := valueobject.OkOr(valueobject.Some(42), domerr.NewValidationError("missing"))
+	tf.RunTest("OkOr on Some - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("OkOr on Some - value passes through", r1.Value() == 42)
+	}
+
+	// ========================================================================
+	// Test: OkOr on None yields the given error
+	// ========================================================================
+
+	r2 := valueobject.OkOr(valueobject.None[int](), domerr.NewValidationError("missing"))
+	tf.RunTest("OkOr on None - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("OkOr on None - error message preserved",
+			r2.ErrorInfo().Message == "missing")
+		tf.RunTest("OkOr on None - error kind preserved",
+			r2.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: OkOrElse on Some does not invoke errFn
+	// ========================================================================
+
+	invoked := false
+	r3 := valueobject.OkOrElse(valueobject.Some("hello"), func() domerr.ErrorType {
+		invoked = true
+		return domerr.NewValidationError("missing")
+	})
+	tf.RunTest("OkOrElse on Some - IsOk returns true", r3.IsOk())
+	tf.RunTest("OkOrElse on Some - errFn not invoked", !invoked)
+	if r3.IsOk() {
+		tf.RunTest("OkOrElse on Some - value passes through", r3.Value() == "hello")
+	}
+
+	// ========================================================================
+	// Test: OkOrElse on None invokes errFn exactly once
+	// ========================================================================
+
+	calls := 0
+	r4 := valueobject.OkOrElse(valueobject.None[string](), func() domerr.ErrorType {
+		calls++
+		return domerr.NewInfrastructureError("lookup failed")
+	})
+	tf.RunTest("OkOrElse on None - IsError returns true", r4.IsError())
+	tf.RunTest("OkOrElse on None - errFn invoked once", calls == 1)
+	if r4.IsError() {
+		tf.RunTest("OkOrElse on None - error from errFn",
+			r4.ErrorInfo().Message == "lookup failed")
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -95,6 +95,23 @@ func (o Option[T]) Value() T {
 	return o.value
 }
 
+// Get returns the contained value and true if Some, or the zero value and
+// false if None. It never panics, mirroring the comma-ok idiom of map
+// lookups and type assertions.
+//
+// Example:
+//
+//	if v, ok := opt.Get(); ok {
+//	    use(v)
+//	}
+func (o Option[T]) Get() (T, bool) {
+	if o.isSome {
+		return o.value, true
+	}
+	var zero T
+	return zero, false
+}
+
 // ============================================================================
 // Unwrap operations (extract value or use default)
 // ============================================================================
@@ -178,6 +195,18 @@ func AndThenTo[T any, U any](o Option[T], f func(T) Option[U]) Option[U] {
 	return None[U]()
 }
 
+// FlatMap is AndThenTo under its conventional functional name: it maps a
+// Some value through f and flattens the resulting Option, short-circuiting
+// on None without calling f. Pipelines that read better in map/flatMap
+// vocabulary can use it interchangeably with AndThenTo.
+//
+// Example:
+//
+//	userOpt := FlatMap(idOpt, func(id int) Option[User] { return findUser(id) })
+func FlatMap[T any, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	return AndThenTo(o, f)
+}
+
 // Filter keeps value only if predicate holds, otherwise None.
 //
 // Example:
@@ -190,6 +219,62 @@ func (o Option[T]) Filter(pred func(T) bool) Option[T] {
 	return None[T]()
 }
 
+// ============================================================================
+// Combining independent Options
+// ============================================================================
+
+// OptionPair carries two independently present values through one Option.
+type OptionPair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines two independent Options into one carrying a pair.
+// Returns Some(pair) only when both inputs are Some, otherwise None.
+//
+// Example:
+//
+//	combined := Zip(firstName, lastName)
+func Zip[A any, B any](a Option[A], b Option[B]) Option[OptionPair[A, B]] {
+	if a.isSome && b.isSome {
+		return Some(OptionPair[A, B]{First: a.value, Second: b.value})
+	}
+	return None[OptionPair[A, B]]()
+}
+
+// ZipWith combines two independent Options through f.
+// Returns Some(f(a, b)) only when both inputs are Some, otherwise None;
+// f is never called unless both values are present.
+//
+// Example:
+//
+//	fullName := ZipWith(firstName, lastName, func(f, l string) string {
+//	    return f + " " + l
+//	})
+func ZipWith[A any, B any, C any](a Option[A], b Option[B], f func(A, B) C) Option[C] {
+	if a.isSome && b.isSome {
+		return Some(f(a.value, b.value))
+	}
+	return None[C]()
+}
+
+// ============================================================================
+// Iteration interop
+// ============================================================================
+
+// ToSlice flattens the Option into a slice: one element for Some, empty for
+// None. This makes Options compose with slice-based code:
+//
+//	acc = append(acc, opt.ToSlice()...)
+//
+// The returned slice is always non-nil.
+func (o Option[T]) ToSlice() []T {
+	if o.isSome {
+		return []T{o.value}
+	}
+	return []T{}
+}
+
 // ============================================================================
 // Fallback
 // ============================================================================
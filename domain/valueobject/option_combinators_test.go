@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// Package valueobject_test covers Option combinators added after the core
+// monad, using the Ada-style test framework for consistent reporting.
+package valueobject_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueobjectOptionZip tests combining two independent Options.
+func TestDomainValueobjectOptionZip(t *testing.T) {
+	tf := test.New("Domain.Valueobject.Option.Zip")
+
+	// ========================================================================
+	// Test: Some/Some yields Some carrying both values
+	// ========================================================================
+
+	z1 := valueobject.Zip(valueobject.Some(42), valueobject.Some("hello"))
+	tf.RunTest("Some/Some - IsSome returns true", z1.IsSome())
+	if z1.IsSome() {
+		pair := z1.Value()
+		tf.RunTest("Some/Some - pair carries both values",
+			pair.First == 42 && pair.Second == "hello")
+	}
+
+	// ========================================================================
+	// Test: Any None input yields None
+	// ========================================================================
+
+	tf.RunTest("Some/None - IsNone returns true",
+		valueobject.Zip(valueobject.Some(1), valueobject.None[string]()).IsNone())
+	tf.RunTest("None/Some - IsNone returns true",
+		valueobject.Zip(valueobject.None[int](), valueobject.Some("x")).IsNone())
+	tf.RunTest("None/None - IsNone returns true",
+		valueobject.Zip(valueobject.None[int](), valueobject.None[string]()).IsNone())
+
+	// ========================================================================
+	// Test: ZipWith combines both values through f
+	// ========================================================================
+
+	full := valueobject.ZipWith(valueobject.Some("Ada"), valueobject.Some("Lovelace"),
+		func(first, last string) string { return first + " " + last })
+	tf.RunTest("ZipWith Some/Some - combined value",
+		full.IsSome() && full.Value() == "Ada Lovelace")
+
+	// ========================================================================
+	// Test: ZipWith never calls f unless both are present
+	// ========================================================================
+
+	invoked := false
+	missing := valueobject.ZipWith(valueobject.Some("Ada"), valueobject.None[string](),
+		func(first, last string) string {
+			invoked = true
+			return first + " " + last
+		})
+	tf.RunTest("ZipWith Some/None - IsNone returns true", missing.IsNone())
+	tf.RunTest("ZipWith Some/None - f not invoked", !invoked)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueobjectOptionToSlice tests Option slice flattening.
+func TestDomainValueobjectOptionToSlice(t *testing.T) {
+	tf := test.New("Domain.Valueobject.Option.ToSlice")
+
+	// ========================================================================
+	// Test: Some yields a one-element slice with the value
+	// ========================================================================
+
+	s1 := valueobject.Some(42).ToSlice()
+	tf.RunTest("Some - one element", len(s1) == 1)
+	tf.RunTest("Some - element is the value", len(s1) == 1 && s1[0] == 42)
+
+	// ========================================================================
+	// Test: None yields a non-nil empty slice
+	// ========================================================================
+
+	s2 := valueobject.None[int]().ToSlice()
+	tf.RunTest("None - non-nil empty slice", s2 != nil && len(s2) == 0)
+
+	// ========================================================================
+	// Test: Flattening many Options via append
+	// ========================================================================
+
+	opts := []valueobject.Option[string]{
+		valueobject.Some("a"),
+		valueobject.None[string](),
+		valueobject.Some("b"),
+	}
+	var acc []string
+	for _, o := range opts {
+		acc = append(acc, o.ToSlice()...)
+	}
+	tf.RunTest("Flatten - only present values accumulate",
+		len(acc) == 2 && acc[0] == "a" && acc[1] == "b")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueobjectOptionGet tests the comma-ok value extraction.
+func TestDomainValueobjectOptionGet(t *testing.T) {
+	tf := test.New("Domain.Valueobject.Option.Get")
+
+	// ========================================================================
+	// Test: Some returns the value and true
+	// ========================================================================
+
+	v, ok := valueobject.Some("hello").Get()
+	tf.RunTest("Some - value and true", ok && v == "hello")
+
+	// ========================================================================
+	// Test: None returns the zero value and false, never panicking
+	// ========================================================================
+
+	s, ok := valueobject.None[string]().Get()
+	tf.RunTest("None string - zero value and false", !ok && s == "")
+
+	type point struct{ X, Y int }
+	p, ok := valueobject.None[point]().Get()
+	tf.RunTest("None struct - zero value and false", !ok && p == point{})
+
+	// ========================================================================
+	// Test: Get drives the comma-ok idiom directly
+	// ========================================================================
+
+	taken := false
+	if _, ok := valueobject.Some(42).Get(); ok {
+		taken = true
+	}
+	tf.RunTest("Comma-ok - branch taken on Some", taken)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueobjectOptionFlatMap tests the AndThenTo alias.
+func TestDomainValueobjectOptionFlatMap(t *testing.T) {
+	tf := test.New("Domain.Valueobject.Option.FlatMap")
+
+	half := func(x int) valueobject.Option[string] {
+		if x%2 != 0 {
+			return valueobject.None[string]()
+		}
+		return valueobject.Some(strconv.Itoa(x / 2))
+	}
+
+	// ========================================================================
+	// Test: Some chains through f, flattening the result
+	// ========================================================================
+
+	r1 := valueobject.FlatMap(valueobject.Some(8), half)
+	tf.RunTest("Some even - chained and flattened",
+		r1.IsSome() && r1.Value() == "4")
+
+	r2 := valueobject.FlatMap(valueobject.Some(7), half)
+	tf.RunTest("Some odd - f's None propagates", r2.IsNone())
+
+	// ========================================================================
+	// Test: None short-circuits without calling f
+	// ========================================================================
+
+	called := false
+	r3 := valueobject.FlatMap(valueobject.None[int](), func(x int) valueobject.Option[string] {
+		called = true
+		return valueobject.Some("unreachable")
+	})
+	tf.RunTest("None - stays None", r3.IsNone())
+	tf.RunTest("None - f not called", !called)
+
+	// ========================================================================
+	// Test: FlatMap and AndThenTo agree
+	// ========================================================================
+
+	a := valueobject.FlatMap(valueobject.Some(8), half)
+	b := valueobject.AndThenTo(valueobject.Some(8), half)
+	tf.RunTest("Alias - identical behavior",
+		a.IsSome() == b.IsSome() && a.Value() == b.Value())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueObjectOptionMonadLaws verifies that Option[T] obeys the
+// monad laws with AndThenTo as bind and Some as return, plus the functor
+// laws for Map/MapTo:
+//
+//	Monad laws:
+//	 1. Left identity:  Some(a) >>= f     ==  f(a)
+//	 2. Right identity: m >>= Some        ==  m
+//	 3. Associativity:  (m >>= f) >>= g   ==  m >>= (x -> f(x) >>= g)
+//
+//	Functor laws:
+//	 1. Identity:    m.Map(id)            ==  m
+//	 2. Composition: m.Map(f).Map(g)      ==  m.Map(g ∘ f)
+//
+// Both Some and None inputs are covered, documenting and enforcing Option's
+// correctness.
+func TestDomainValueObjectOptionMonadLaws(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Option.MonadLaws")
+
+	// Representative Kleisli arrows for the laws
+	half := func(x int) valueobject.Option[int] {
+		if x%2 == 0 {
+			return valueobject.Some(x / 2)
+		}
+		return valueobject.None[int]()
+	}
+	toString := func(x int) valueobject.Option[string] {
+		return valueobject.Some(strconv.Itoa(x))
+	}
+
+	// ========================================================================
+	// Monad law 1: Left identity - Some(a).AndThenTo(f) == f(a)
+	// ========================================================================
+
+	tf.RunTest("Left identity - f returns Some",
+		valueobject.AndThenTo(valueobject.Some(42), half) == half(42))
+	tf.RunTest("Left identity - f returns None",
+		valueobject.AndThenTo(valueobject.Some(7), half) == half(7))
+	tf.RunTest("Left identity - type-changing f",
+		valueobject.AndThenTo(valueobject.Some(7), toString) == toString(7))
+
+	// ========================================================================
+	// Monad law 2: Right identity - m.AndThenTo(Some) == m
+	// ========================================================================
+
+	mSome := valueobject.Some(42)
+	tf.RunTest("Right identity - Some input",
+		valueobject.AndThenTo(mSome, valueobject.Some[int]) == mSome)
+
+	mNone := valueobject.None[int]()
+	tf.RunTest("Right identity - None input",
+		valueobject.AndThenTo(mNone, valueobject.Some[int]) == mNone)
+
+	// ========================================================================
+	// Monad law 3: Associativity -
+	//   (m >>= f) >>= g  ==  m >>= (x -> f(x) >>= g)
+	// ========================================================================
+
+	assoc := func(name string, m valueobject.Option[int]) {
+		lhs := valueobject.AndThenTo(valueobject.AndThenTo(m, half), toString)
+		rhs := valueobject.AndThenTo(m, func(x int) valueobject.Option[string] {
+			return valueobject.AndThenTo(half(x), toString)
+		})
+		tf.RunTest("Associativity - "+name, lhs == rhs)
+	}
+
+	assoc("Some input, f returns Some", valueobject.Some(10))
+	assoc("Some input, f returns None", valueobject.Some(9))
+	assoc("None input short-circuits", mNone)
+
+	// ========================================================================
+	// Functor law 1: Identity - m.Map(id) == m
+	// ========================================================================
+
+	id := func(x int) int { return x }
+	tf.RunTest("Functor identity - Some input", mSome.Map(id) == mSome)
+	tf.RunTest("Functor identity - None input", mNone.Map(id) == mNone)
+
+	// ========================================================================
+	// Functor law 2: Composition - m.Map(f).Map(g) == m.Map(g ∘ f)
+	// ========================================================================
+
+	addOne := func(x int) int { return x + 1 }
+	double := func(x int) int { return x * 2 }
+	composed := func(x int) int { return double(addOne(x)) }
+
+	tf.RunTest("Functor composition - Some input",
+		mSome.Map(addOne).Map(double) == mSome.Map(composed))
+	tf.RunTest("Functor composition - None input",
+		mNone.Map(addOne).Map(double) == mNone.Map(composed))
+
+	// MapTo must also satisfy composition across type changes
+	lhs := valueobject.MapTo(mSome.Map(addOne), strconv.Itoa)
+	rhs := valueobject.MapTo(mSome, func(x int) string { return strconv.Itoa(addOne(x)) })
+	tf.RunTest("Functor composition - MapTo type change", lhs == rhs)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -26,6 +26,8 @@ package valueobject
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
 
 	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
 )
@@ -34,6 +36,11 @@ const (
 	// MaxNameLength is the maximum allowed length for a person's name.
 	// This is a reasonable limit for person names in most applications.
 	MaxNameLength = 100
+
+	// MinNameLength is the minimum allowed length for a person's name.
+	// The default of 1 preserves the historical "non-empty" rule; stricter
+	// products can pass a larger bound to CreatePersonWithBounds.
+	MinNameLength = 1
 )
 
 // Person represents a person's name as an immutable value object.
@@ -71,21 +78,109 @@ type Person struct {
 //   - Post: If name is empty or exceeds MaxNameLength, returns Err
 //   - Post: If valid, returns Ok with Person where GetName() returns exact input
 func CreatePerson(name string) domerr.Result[Person] {
-	// Validation 1: Check for empty string
+	return CreatePersonWithBounds(name, MinNameLength, MaxNameLength)
+}
+
+// CreatePersonWithBounds creates a Person validated against caller-supplied
+// length bounds instead of the package defaults. The error message names the
+// violated bound, so callers can surface which rule failed.
+//
+// Validation rules:
+//  1. Name must not be empty
+//  2. Name length must be at least min
+//  3. Name length must not exceed max
+//
+// Contract:
+//   - Pre: min >= 1 and min <= max (caller responsibility)
+//   - Post: If any bound is violated, returns Err naming that bound
+//   - Post: If valid, returns Ok with Person where GetName() returns exact input
+func CreatePersonWithBounds(name string, min, max int) domerr.Result[Person] {
+	// Validation 1: Check for empty string (its own message predates bounds)
 	if len(name) == 0 {
 		return domerr.Err[Person](domerr.NewValidationError("Person name cannot be empty"))
 	}
 
-	// Validation 2: Check maximum length
-	if len(name) > MaxNameLength {
+	// Validation 2: Check minimum length
+	if len(name) < min {
 		return domerr.Err[Person](domerr.NewValidationError(
-			fmt.Sprintf("Person name exceeds maximum length of %d characters", MaxNameLength)))
+			fmt.Sprintf("Person name is shorter than minimum length of %d characters", min)))
+	}
+
+	// Validation 3: Check maximum length
+	if len(name) > max {
+		return domerr.Err[Person](domerr.NewValidationError(
+			fmt.Sprintf("Person name exceeds maximum length of %d characters", max)))
 	}
 
 	// All validations passed - create the value object
 	return domerr.Ok(Person{name: name})
 }
 
+// CreatePersonNormalized creates a Person after normalizing whitespace in
+// the name: leading/trailing whitespace is trimmed and internal runs of
+// whitespace collapse to a single space. The normalized form is what gets
+// stored, so "  alice " and "alice" produce equal Persons.
+//
+// CreatePerson remains byte-preserving; use this constructor when callers
+// may hand in padded or inconsistently spaced input (CLI arguments, file
+// lines) and duplicates should be avoided.
+//
+// Contract:
+//   - Pre: name parameter can be any string
+//   - Post: An all-whitespace name normalizes to empty and returns Err
+//   - Post: If valid, GetName() returns the normalized form
+func CreatePersonNormalized(name string) domerr.Result[Person] {
+	return CreatePerson(strings.Join(strings.Fields(name), " "))
+}
+
+// CreatePersonRunes creates a Person whose length validation counts runes
+// rather than bytes: a 100-rune name of multibyte characters is accepted,
+// while byte length is ignored entirely.
+//
+// CreatePerson keeps its historical byte semantics (len(name)) for callers
+// that size storage in bytes; use this constructor when MaxNameLength is
+// meant as a bound on characters the user sees.
+//
+// Contract:
+//   - Pre: name parameter can be any string
+//   - Post: If the rune count is 0 or exceeds MaxNameLength, returns Err
+//   - Post: If valid, returns Ok with Person where GetName() returns exact input
+func CreatePersonRunes(name string) domerr.Result[Person] {
+	// Build on the reusable NonEmptyString block, rewording its generic
+	// diagnostics in Person terms so callers see identical messages.
+	return domerr.MapTo(
+		NewNonEmptyString(name, MaxNameLength).MapError(func(e domerr.ErrorType) domerr.ErrorType {
+			return e.WithMessage(strings.Replace(e.Message, "value", "Person name", 1))
+		}),
+		func(s NonEmptyString) Person { return Person{name: s.String()} })
+}
+
+// CreatePersonStrict creates a Person with the standard bounds checks plus a
+// character-class check: any Unicode control character (including '\n', '\r'
+// and '\t') is rejected, since it would corrupt console output. The error
+// identifies the offending rune position (0-based, in runes).
+//
+// Unicode letters, marks, spaces, hyphens and apostrophes all pass, so names
+// like "José García" and "O'Connor" remain valid.
+//
+// Contract:
+//   - Pre: name parameter can be any string
+//   - Post: If name contains a control rune, returns Err naming its position
+//   - Post: If valid, returns Ok with Person where GetName() returns exact input
+func CreatePersonStrict(name string) domerr.Result[Person] {
+	return domerr.AndThenTo(CreatePerson(name), func(p Person) domerr.Result[Person] {
+		pos := 0
+		for _, r := range name {
+			if unicode.IsControl(r) {
+				return domerr.Err[Person](domerr.NewValidationError(
+					fmt.Sprintf("Person name contains control character %q at rune position %d", r, pos)))
+			}
+			pos++
+		}
+		return domerr.Ok(p)
+	})
+}
+
 // GetName returns the string representation of the person's name.
 //
 // Contract:
@@ -106,6 +201,76 @@ func (p Person) GreetingMessage() string {
 	return fmt.Sprintf("Hello, %s!", p.name)
 }
 
+// Language identifies a greeting locale. The zero value ("") is treated
+// as English wherever a Language is consumed, so callers that never think
+// about locales get the historical behavior.
+type Language string
+
+// Supported greeting languages. Unknown values fall back to English.
+const (
+	English Language = "en"
+	French  Language = "fr"
+	Spanish Language = "es"
+	German  Language = "de"
+)
+
+// greetingFormats maps each supported language to its "{name}" greeting
+// template. Kept unexported: the supported set is part of the domain and
+// grows here, not at call sites.
+var greetingFormats = map[Language]string{
+	English: "Hello, {name}!",
+	French:  "Bonjour, {name} !",
+	Spanish: "¡Hola, {name}!",
+	German:  "Hallo, {name}!",
+}
+
+// GreetingMessageIn generates a greeting for this person in the given
+// language, falling back to English for the zero value or an unknown
+// language. English output is identical to GreetingMessage.
+//
+// Pure domain logic - no side effects.
+//
+// Example:
+//
+//	person.GreetingMessageIn(valueobject.French) // "Bonjour, Alice !"
+func (p Person) GreetingMessageIn(lang Language) string {
+	format, ok := greetingFormats[lang]
+	if !ok {
+		format = greetingFormats[English]
+	}
+	return p.GreetingMessageFormat(format)
+}
+
+// GreetingMessageFormat generates a greeting from a caller-supplied
+// template, substituting every occurrence of "{name}" with the person's
+// name. A template without the placeholder is returned verbatim - the
+// method does not guess where the name should go - so callers own
+// validating their templates.
+//
+// Pure domain logic - no side effects.
+//
+// Example:
+//
+//	person.GreetingMessageFormat("Hi {name} :)") // "Hi Alice :)"
+func (p Person) GreetingMessageFormat(format string) string {
+	return strings.ReplaceAll(format, "{name}", p.name)
+}
+
+// Equal reports whether two Persons have byte-identical names. Value
+// objects compare by value, so this is the identity test for Person —
+// useful for deduplicating a greeting list without reaching for GetName
+// on both sides.
+func (p Person) Equal(other Person) bool {
+	return p.name == other.name
+}
+
+// EqualFold reports whether two Persons have names equal under Unicode
+// case-folding, so "Bob" and "bob" match. Use for case-insensitive
+// deduplication; Equal remains the strict identity.
+func (p Person) EqualFold(other Person) bool {
+	return strings.EqualFold(p.name, other.name)
+}
+
 // IsValid checks if the person satisfies the type invariant.
 //
 // Type Invariant: A Person is valid if and only if its name is non-empty.
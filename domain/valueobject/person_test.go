@@ -71,11 +71,13 @@ func TestDomainValueObjectPerson(t *testing.T) {
 		person := r4.Value()
 		greeting := person.GreetingMessage()
 		tf.RunTest("GreetingMessage - starts with 'Hello, '",
-			strings.HasPrefix(greeting, "Hello, "))
+			valueobject.HasGreetingPrefix(greeting))
 		tf.RunTest("GreetingMessage - contains name",
 			strings.Contains(greeting, "Bob"))
 		tf.RunTest("GreetingMessage - ends with '!'",
-			strings.HasSuffix(greeting, "!"))
+			valueobject.HasGreetingTerminator(greeting))
+		tf.RunTest("GreetingMessage - well-formed greeting",
+			valueobject.IsWellFormedGreeting(greeting))
 		tf.RunTest("GreetingMessage - exact format",
 			greeting == "Hello, Bob!")
 	}
@@ -136,3 +138,272 @@ func TestDomainValueObjectPerson(t *testing.T) {
 	// Print summary and fail test if any failed
 	tf.Summary(t)
 }
+
+// TestDomainValueObjectPersonNormalized tests the whitespace-normalizing constructor.
+func TestDomainValueObjectPersonNormalized(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.Normalized")
+
+	// ========================================================================
+	// Test: Padding and internal runs collapse to single spaces
+	// ========================================================================
+
+	r1 := valueobject.CreatePersonNormalized("  Bob   Smith ")
+	tf.RunTest("Padded name - creation succeeds", r1.IsOk())
+	tf.RunTest("Padded name - normalized form stored",
+		r1.IsOk() && r1.Value().GetName() == "Bob Smith")
+
+	// ========================================================================
+	// Test: Already-clean names pass through unchanged
+	// ========================================================================
+
+	r2 := valueobject.CreatePersonNormalized("Alice")
+	tf.RunTest("Clean name - preserved", r2.IsOk() && r2.Value().GetName() == "Alice")
+
+	// ========================================================================
+	// Test: All-whitespace input normalizes to empty and fails validation
+	// ========================================================================
+
+	r3 := valueobject.CreatePersonNormalized("   \t  ")
+	tf.RunTest("Whitespace only - ValidationError",
+		r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: CreatePerson remains byte-preserving for the same input
+	// ========================================================================
+
+	r4 := valueobject.CreatePerson("  Bob   Smith ")
+	tf.RunTest("CreatePerson - bytes preserved",
+		r4.IsOk() && r4.Value().GetName() == "  Bob   Smith ")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueObjectPersonWithBounds tests caller-supplied length bounds.
+func TestDomainValueObjectPersonWithBounds(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.WithBounds")
+
+	const min, max = 2, 10
+
+	// ========================================================================
+	// Test: Boundary values around the minimum
+	// ========================================================================
+
+	r1 := valueobject.CreatePersonWithBounds("X", min, max)
+	tf.RunTest("min-1 - rejected", r1.IsError())
+	tf.RunTest("min-1 - message names the minimum",
+		r1.IsError() && strings.Contains(r1.ErrorInfo().Message, "minimum"))
+
+	r2 := valueobject.CreatePersonWithBounds("Xy", min, max)
+	tf.RunTest("min - accepted", r2.IsOk())
+
+	// ========================================================================
+	// Test: Boundary values around the maximum
+	// ========================================================================
+
+	r3 := valueobject.CreatePersonWithBounds(strings.Repeat("a", max), min, max)
+	tf.RunTest("max - accepted", r3.IsOk())
+
+	r4 := valueobject.CreatePersonWithBounds(strings.Repeat("a", max+1), min, max)
+	tf.RunTest("max+1 - rejected", r4.IsError())
+	tf.RunTest("max+1 - message names the maximum",
+		r4.IsError() && strings.Contains(r4.ErrorInfo().Message, "maximum"))
+
+	// ========================================================================
+	// Test: Empty input keeps its dedicated message
+	// ========================================================================
+
+	r5 := valueobject.CreatePersonWithBounds("", min, max)
+	tf.RunTest("Empty - rejected with empty-name message",
+		r5.IsError() && strings.Contains(r5.ErrorInfo().Message, "empty"))
+
+	// ========================================================================
+	// Test: CreatePerson still applies the package defaults
+	// ========================================================================
+
+	tf.RunTest("Defaults - single character accepted (MinNameLength is 1)",
+		valueobject.CreatePerson("X").IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueObjectPersonStrict tests the control-character rejection.
+func TestDomainValueObjectPersonStrict(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.Strict")
+
+	// ========================================================================
+	// Test: Control characters are rejected with the rune position
+	// ========================================================================
+
+	r1 := valueobject.CreatePersonStrict("Al\nice")
+	tf.RunTest("Embedded newline - rejected", r1.IsError())
+	tf.RunTest("Embedded newline - position identified",
+		r1.IsError() && strings.Contains(r1.ErrorInfo().Message, "position 2"))
+
+	r2 := valueobject.CreatePersonStrict("Bob\tSmith")
+	tf.RunTest("Embedded tab - rejected", r2.IsError())
+	tf.RunTest("Embedded tab - kind is validation",
+		r2.IsError() && r2.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: Position is counted in runes, not bytes
+	// ========================================================================
+
+	r3 := valueobject.CreatePersonStrict("José\rGarcía")
+	tf.RunTest("After multibyte rune - rune position reported",
+		r3.IsError() && strings.Contains(r3.ErrorInfo().Message, "position 4"))
+
+	// ========================================================================
+	// Test: Unicode letters, marks, spaces and punctuation still pass
+	// ========================================================================
+
+	tf.RunTest("Accented name - accepted", valueobject.CreatePersonStrict("José García").IsOk())
+	tf.RunTest("Apostrophe - accepted", valueobject.CreatePersonStrict("O'Connor").IsOk())
+	tf.RunTest("Hyphen - accepted", valueobject.CreatePersonStrict("Mary-Jane").IsOk())
+
+	// ========================================================================
+	// Test: The standard bounds checks still run first
+	// ========================================================================
+
+	tf.RunTest("Empty name - still rejected", valueobject.CreatePersonStrict("").IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueObjectPersonEqual tests value-object identity comparison.
+func TestDomainValueObjectPersonEqual(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.Equal")
+
+	bob := valueobject.CreatePerson("Bob").Value()
+	bobLower := valueobject.CreatePerson("bob").Value()
+	alice := valueobject.CreatePerson("Alice").Value()
+
+	// ========================================================================
+	// Test: Equal compares names byte for byte
+	// ========================================================================
+
+	tf.RunTest("Identical names - equal",
+		bob.Equal(valueobject.CreatePerson("Bob").Value()))
+	tf.RunTest("Differing names - not equal", !bob.Equal(alice))
+	tf.RunTest("Case difference - not equal strictly", !bob.Equal(bobLower))
+
+	// ========================================================================
+	// Test: EqualFold matches under Unicode case-folding
+	// ========================================================================
+
+	tf.RunTest("Case difference - EqualFold matches", bob.EqualFold(bobLower))
+	tf.RunTest("Differing names - EqualFold still distinguishes", !bob.EqualFold(alice))
+
+	jose := valueobject.CreatePerson("José").Value()
+	joseUpper := valueobject.CreatePerson("JOSÉ").Value()
+	tf.RunTest("Unicode case - EqualFold matches", jose.EqualFold(joseUpper))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueObjectPersonRunes tests rune-count length semantics.
+func TestDomainValueObjectPersonRunes(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.Runes")
+
+	emoji100 := strings.Repeat("👋", valueobject.MaxNameLength)
+	ascii100 := strings.Repeat("a", valueobject.MaxNameLength)
+
+	// ========================================================================
+	// Test: 100 multibyte runes sit exactly on the boundary and pass
+	// ========================================================================
+
+	tf.RunTest("100 emoji - accepted by rune count",
+		valueobject.CreatePersonRunes(emoji100).IsOk())
+	tf.RunTest("100 emoji - rejected by byte count (historical semantics)",
+		valueobject.CreatePerson(emoji100).IsError())
+
+	// ========================================================================
+	// Test: ASCII behaves identically under both semantics
+	// ========================================================================
+
+	tf.RunTest("100 ASCII - accepted by rune count",
+		valueobject.CreatePersonRunes(ascii100).IsOk())
+	tf.RunTest("100 ASCII - accepted by byte count",
+		valueobject.CreatePerson(ascii100).IsOk())
+	tf.RunTest("101 ASCII - rejected by rune count",
+		valueobject.CreatePersonRunes(ascii100+"a").IsError())
+
+	// ========================================================================
+	// Test: One rune over the boundary is rejected
+	// ========================================================================
+
+	tf.RunTest("101 emoji - rejected by rune count",
+		valueobject.CreatePersonRunes(emoji100+"👋").IsError())
+
+	// ========================================================================
+	// Test: Empty input is still rejected
+	// ========================================================================
+
+	tf.RunTest("Empty - rejected", valueobject.CreatePersonRunes("").IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueObjectPersonGreetingFormat tests template-based greetings.
+func TestDomainValueObjectPersonGreetingFormat(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.GreetingFormat")
+
+	person := valueobject.CreatePerson("Alice").Value()
+
+	// ========================================================================
+	// Test: The placeholder is replaced wherever it appears
+	// ========================================================================
+
+	tf.RunTest("Simple template - name substituted",
+		person.GreetingMessageFormat("Hi {name} :)") == "Hi Alice :)")
+	tf.RunTest("Repeated placeholder - every occurrence substituted",
+		person.GreetingMessageFormat("{name}, oh {name}!") == "Alice, oh Alice!")
+	tf.RunTest("Default template - matches GreetingMessage",
+		person.GreetingMessageFormat("Hello, {name}!") == person.GreetingMessage())
+
+	// ========================================================================
+	// Test: A template without the placeholder is returned verbatim
+	// ========================================================================
+
+	tf.RunTest("No placeholder - verbatim",
+		person.GreetingMessageFormat("Welcome aboard!") == "Welcome aboard!")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestDomainValueObjectPersonLanguages tests localized greetings.
+func TestDomainValueObjectPersonLanguages(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.Languages")
+
+	person := valueobject.CreatePerson("Alice").Value()
+
+	// ========================================================================
+	// Test: Each supported language has its own greeting
+	// ========================================================================
+
+	tf.RunTest("English - classic greeting",
+		person.GreetingMessageIn(valueobject.English) == "Hello, Alice!")
+	tf.RunTest("French - Bonjour",
+		person.GreetingMessageIn(valueobject.French) == "Bonjour, Alice !")
+	tf.RunTest("Spanish - Hola",
+		person.GreetingMessageIn(valueobject.Spanish) == "¡Hola, Alice!")
+	tf.RunTest("German - Hallo",
+		person.GreetingMessageIn(valueobject.German) == "Hallo, Alice!")
+
+	// ========================================================================
+	// Test: Zero value and unknown languages fall back to English
+	// ========================================================================
+
+	tf.RunTest("Zero value - English fallback",
+		person.GreetingMessageIn("") == person.GreetingMessage())
+	tf.RunTest("Unknown language - English fallback",
+		person.GreetingMessageIn("tlh") == person.GreetingMessage())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
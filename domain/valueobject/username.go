@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Username value object for the greeter domain
+
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+const (
+	// MinUsernameLength is the minimum allowed length for a username.
+	MinUsernameLength = 3
+
+	// MaxUsernameLength is the maximum allowed length for a username.
+	MaxUsernameLength = 30
+)
+
+// Username represents a normalized account identifier as an immutable value object.
+//
+// Unlike Person (a display name that preserves input exactly), Username enforces
+// a strict character set and normalizes its input, demonstrating a stricter
+// validation policy in the same value-object pattern.
+//
+// Design Pattern: Value Object
+//   - Immutable after creation
+//   - Validation enforced via CreateUsername smart constructor
+//   - Input is normalized to lowercase before storage
+//
+// Contract:
+//   - Value contains only lowercase ASCII letters, digits, and underscores
+//   - Length is always within [MinUsernameLength, MaxUsernameLength]
+//   - Use CreateUsername() to instantiate, not struct literal
+type Username struct {
+	value string
+}
+
+// CreateUsername creates a new Username value object with validation.
+//
+// The input is normalized to lowercase before validation, so "Alice_99" and
+// "alice_99" produce the same Username.
+//
+// Validation rules:
+//  1. Length must be at least MinUsernameLength
+//  2. Length must not exceed MaxUsernameLength
+//  3. Only ASCII letters, digits, and underscores are allowed
+//
+// Returns:
+//   - domerr.Result[Username] - Ok if valid, Err if validation fails
+//
+// Contract:
+//   - Pre: s parameter can be any string
+//   - Post: If invalid, returns Err(ValidationError)
+//   - Post: If valid, returns Ok with Username where GetValue() is lowercase
+func CreateUsername(s string) domerr.Result[Username] {
+	// Normalization: usernames are case-insensitive, stored lowercase
+	normalized := strings.ToLower(s)
+
+	// Validation 1: Check minimum length
+	if len(normalized) < MinUsernameLength {
+		return domerr.Err[Username](domerr.NewValidationError(
+			fmt.Sprintf("Username must be at least %d characters", MinUsernameLength)))
+	}
+
+	// Validation 2: Check maximum length
+	if len(normalized) > MaxUsernameLength {
+		return domerr.Err[Username](domerr.NewValidationError(
+			fmt.Sprintf("Username exceeds maximum length of %d characters", MaxUsernameLength)))
+	}
+
+	// Validation 3: Check allowed character set (lowercase alphanumerics + underscore)
+	for _, r := range normalized {
+		if !isUsernameRune(r) {
+			return domerr.Err[Username](domerr.NewValidationError(
+				fmt.Sprintf("Username contains disallowed character %q", r)))
+		}
+	}
+
+	// All validations passed - create the value object
+	return domerr.Ok(Username{value: normalized})
+}
+
+// isUsernameRune reports whether r is allowed in a normalized username.
+func isUsernameRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_'
+}
+
+// GetValue returns the normalized (lowercase) username string.
+//
+// Contract:
+//   - Post: Result length is within [MinUsernameLength, MaxUsernameLength]
+//   - Post: Result contains only lowercase alphanumerics and underscores
+func (u Username) GetValue() string {
+	return u.value
+}
+
+// IsValid checks if the username satisfies the type invariant.
+//
+// Type Invariant: A Username is valid if and only if its value is non-empty.
+// This method is primarily used for testing and debugging.
+func (u Username) IsValid() bool {
+	return len(u.value) > 0
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueObjectUsername tests the Username value object.
+// Uses Ada-style [PASS]/[FAIL] output for uniform cross-language reporting.
+func TestDomainValueObjectUsername(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Username")
+
+	// ========================================================================
+	// Test: CreateUsername with valid name
+	// ========================================================================
+
+	r1 := valueobject.CreateUsername("alice_99")
+	tf.RunTest("CreateUsername valid - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		username := r1.Value()
+		tf.RunTest("CreateUsername valid - GetValue returns correct value",
+			username.GetValue() == "alice_99")
+		tf.RunTest("CreateUsername valid - IsValid returns true",
+			username.IsValid())
+	}
+
+	// ========================================================================
+	// Test: CreateUsername too short (validation error)
+	// ========================================================================
+
+	r2 := valueobject.CreateUsername("ab")
+	tf.RunTest("CreateUsername too short - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		info := r2.ErrorInfo()
+		tf.RunTest("CreateUsername too short - error kind is ValidationError",
+			info.Kind == domerr.ValidationError)
+		tf.RunTest("CreateUsername too short - error message mentions 'at least'",
+			strings.Contains(info.Message, "at least"))
+	}
+
+	// ========================================================================
+	// Test: CreateUsername too long (validation error)
+	// ========================================================================
+
+	longName := strings.Repeat("a", valueobject.MaxUsernameLength+1)
+	r3 := valueobject.CreateUsername(longName)
+	tf.RunTest("CreateUsername too long - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		info := r3.ErrorInfo()
+		tf.RunTest("CreateUsername too long - error kind is ValidationError",
+			info.Kind == domerr.ValidationError)
+		tf.RunTest("CreateUsername too long - error message mentions 'exceeds'",
+			strings.Contains(info.Message, "exceeds"))
+	}
+
+	// ========================================================================
+	// Test: Uppercase input is normalized to lowercase
+	// ========================================================================
+
+	r4 := valueobject.CreateUsername("Alice_99")
+	tf.RunTest("CreateUsername uppercase - IsOk returns true", r4.IsOk())
+	if r4.IsOk() {
+		username := r4.Value()
+		tf.RunTest("CreateUsername uppercase - normalized to lowercase",
+			username.GetValue() == "alice_99")
+	}
+
+	// ========================================================================
+	// Test: Disallowed characters (validation error)
+	// ========================================================================
+
+	for _, bad := range []string{"alice!", "ali ce", "alice-99", "josé"} {
+		r5 := valueobject.CreateUsername(bad)
+		tf.RunTest("CreateUsername disallowed char '"+bad+"' - IsError returns true",
+			r5.IsError())
+		if r5.IsError() {
+			info := r5.ErrorInfo()
+			tf.RunTest("CreateUsername disallowed char '"+bad+"' - kind is ValidationError",
+				info.Kind == domerr.ValidationError)
+		}
+	}
+
+	// ========================================================================
+	// Test: Minimum length boundary (exactly MinUsernameLength)
+	// ========================================================================
+
+	r6 := valueobject.CreateUsername("abc")
+	tf.RunTest("CreateUsername min length - IsOk returns true", r6.IsOk())
+
+	// ========================================================================
+	// Test: Maximum length boundary (exactly MaxUsernameLength)
+	// ========================================================================
+
+	maxName := strings.Repeat("a", valueobject.MaxUsernameLength)
+	r7 := valueobject.CreateUsername(maxName)
+	tf.RunTest("CreateUsername max length - IsOk returns true", r7.IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
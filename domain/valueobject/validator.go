@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Composable name validation rules
+
+package valueobject
+
+import (
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Validator is a single name validation rule: it returns Ok with the
+// (unchanged) name when the rule passes, or a ValidationError describing
+// the violation.
+//
+// Rules compose with And and Or, so policies like "length OK AND (in
+// allowlist OR matches pattern)" read as they are spoken:
+//
+//	policy := And(lengthOK, Or(inAllowlist, matchesPattern))
+//	result := policy(name)
+type Validator func(name string) domerr.Result[string]
+
+// And combines validators so that ALL must pass. Every validator runs -
+// failures do not short-circuit - and all violations are aggregated into a
+// single ValidationError via MultiError, so the caller sees the complete
+// list of broken rules at once.
+//
+// With no validators, And vacuously passes.
+func And(validators ...Validator) Validator {
+	return func(name string) domerr.Result[string] {
+		var multi domerr.MultiError
+		for _, v := range validators {
+			if r := v(name); r.IsError() {
+				multi.Append(r.ErrorInfo())
+			}
+		}
+		if multi.HasErrors() {
+			return domerr.Err[string](multi.ToErrorType(domerr.ValidationError))
+		}
+		return domerr.Ok(name)
+	}
+}
+
+// Or combines validators so that AT LEAST ONE must pass. Validators run in
+// order and Or short-circuits on the first success; later validators are
+// never invoked. When all fail, the violations are aggregated into a single
+// ValidationError via MultiError.
+//
+// With no validators, Or fails: there is no rule that could have passed.
+func Or(validators ...Validator) Validator {
+	return func(name string) domerr.Result[string] {
+		var multi domerr.MultiError
+		for _, v := range validators {
+			r := v(name)
+			if r.IsOk() {
+				return r
+			}
+			multi.Append(r.ErrorInfo())
+		}
+		if !multi.HasErrors() {
+			return domerr.Err[string](domerr.NewValidationError(
+				"Or requires at least one validator"))
+		}
+		return domerr.Err[string](multi.ToErrorType(domerr.ValidationError))
+	}
+}
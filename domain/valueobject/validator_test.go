@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueobjectValidatorCombinators tests And/Or rule composition.
+func TestDomainValueobjectValidatorCombinators(t *testing.T) {
+	tf := test.New("Domain.Valueobject.Validator")
+
+	shortEnough := func(name string) domerr.Result[string] {
+		if len(name) > 10 {
+			return domerr.Err[string](domerr.NewValidationError("name too long"))
+		}
+		return domerr.Ok(name)
+	}
+	inAllowlist := func(name string) domerr.Result[string] {
+		if name == "Alice" || name == "Bob" {
+			return domerr.Ok(name)
+		}
+		return domerr.Err[string](domerr.NewValidationError("name not in allowlist"))
+	}
+	noDigits := func(name string) domerr.Result[string] {
+		if strings.ContainsAny(name, "0123456789") {
+			return domerr.Err[string](domerr.NewValidationError("name contains digits"))
+		}
+		return domerr.Ok(name)
+	}
+
+	// ========================================================================
+	// Test: And passes when every rule passes
+	// ========================================================================
+
+	both := valueobject.And(shortEnough, noDigits)
+	r1 := both("Alice")
+	tf.RunTest("And all pass - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("And all pass - name unchanged", r1.Value() == "Alice")
+	}
+
+	// ========================================================================
+	// Test: And aggregates every violation, not just the first
+	// ========================================================================
+
+	r2 := both("agent007agent007")
+	tf.RunTest("And all fail - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		msg := r2.ErrorInfo().Message
+		tf.RunTest("And all fail - both violations aggregated",
+			strings.Contains(msg, "name too long") &&
+				strings.Contains(msg, "name contains digits"))
+		tf.RunTest("And all fail - kind is validation",
+			r2.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: Or passes on the first success and short-circuits
+	// ========================================================================
+
+	laterInvoked := false
+	spy := func(name string) domerr.Result[string] {
+		laterInvoked = true
+		return domerr.Ok(name)
+	}
+	either := valueobject.Or(inAllowlist, spy)
+	r3 := either("Alice")
+	tf.RunTest("Or first passes - IsOk returns true", r3.IsOk())
+	tf.RunTest("Or first passes - later validator not invoked", !laterInvoked)
+
+	// ========================================================================
+	// Test: Or aggregates all violations when every rule fails
+	// ========================================================================
+
+	r4 := valueobject.Or(inAllowlist, noDigits)("agent007")
+	tf.RunTest("Or all fail - IsError returns true", r4.IsError())
+	if r4.IsError() {
+		msg := r4.ErrorInfo().Message
+		tf.RunTest("Or all fail - violations aggregated",
+			strings.Contains(msg, "name not in allowlist") &&
+				strings.Contains(msg, "name contains digits"))
+	}
+
+	// ========================================================================
+	// Test: Nested And/Or expresses "length OK AND (allowlist OR no digits)"
+	// ========================================================================
+
+	policy := valueobject.And(shortEnough, valueobject.Or(inAllowlist, noDigits))
+	tf.RunTest("Nested - allowlisted name passes", policy("Bob").IsOk())
+	tf.RunTest("Nested - digit-free name passes via Or", policy("Carol").IsOk())
+	tf.RunTest("Nested - digits outside allowlist fail", policy("R2D2").IsError())
+	tf.RunTest("Nested - overlong name fails outer And",
+		policy("Bartholomew-Longname").IsError())
+
+	// ========================================================================
+	// Test: Degenerate cases - empty And passes, empty Or fails
+	// ========================================================================
+
+	tf.RunTest("Empty And - vacuously passes", valueobject.And()("x").IsOk())
+	tf.RunTest("Empty Or - fails", valueobject.Or()("x").IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Asynchronous tee output adapter
+
+package adapter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// AsyncTeeWriter writes synchronously to a primary WriterPort and ships a
+// copy of each message to a secondary WriterPort on a background goroutine.
+//
+// Use this when primary output must stay fast (e.g. stdout) while a copy is
+// delivered to a slow remote sink without blocking the caller.
+//
+// Error Policy:
+//   - Primary errors propagate to the caller (the primary write is the
+//     operation's success criterion)
+//   - Secondary errors are counted, not returned; inspect them via
+//     SecondaryErrors() after Close
+//
+// Lifecycle:
+//   - Create with NewAsyncTeeWriter
+//   - Write messages via the WriterPort contract
+//   - Call Close to stop accepting messages and drain the secondary queue
+//
+// Implements: outbound.WriterPort
+type AsyncTeeWriter struct {
+	primary   outbound.WriterPort
+	secondary outbound.WriterPort
+
+	queue     chan string
+	done      chan struct{}
+	closeOnce sync.Once
+	secErrs   atomic.Int64
+}
+
+// asyncTeeQueueSize bounds the secondary queue. When the queue is full,
+// Write blocks until the background goroutine catches up, applying natural
+// backpressure rather than dropping messages.
+const asyncTeeQueueSize = 64
+
+// NewAsyncTeeWriter creates an AsyncTeeWriter and starts its background
+// delivery goroutine for the secondary sink.
+//
+// Usage:
+//
+//	tee := adapter.NewAsyncTeeWriter(consoleWriter, remoteWriter)
+//	tee.Write(ctx, "Hello, Alice!")
+//	tee.Close() // drains pending secondary deliveries
+func NewAsyncTeeWriter(primary, secondary outbound.WriterPort) *AsyncTeeWriter {
+	tw := &AsyncTeeWriter{
+		primary:   primary,
+		secondary: secondary,
+		queue:     make(chan string, asyncTeeQueueSize),
+		done:      make(chan struct{}),
+	}
+
+	// Background delivery loop: drain the queue into the secondary sink,
+	// counting (not propagating) failures. Uses context.Background() because
+	// the caller's context may be done by the time delivery happens.
+	go func() {
+		defer close(tw.done)
+		for msg := range tw.queue {
+			if r := tw.secondary.Write(context.Background(), msg); r.IsError() {
+				tw.secErrs.Add(1)
+			}
+		}
+	}()
+
+	return tw
+}
+
+// Write writes the message synchronously to the primary sink and enqueues a
+// copy for asynchronous delivery to the secondary sink.
+//
+// Contract:
+//   - Returns the primary writer's Result (secondary errors are only counted)
+//   - The secondary copy is enqueued even if the primary write fails, so the
+//     secondary sink sees the full message stream
+//   - Must not be called after Close (messages would be lost on a closed queue)
+func (tw *AsyncTeeWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	// Synchronous primary write - this Result is the caller's outcome
+	primaryResult := tw.primary.Write(ctx, message)
+
+	// Asynchronous secondary delivery via the background goroutine
+	tw.queue <- message
+
+	return primaryResult
+}
+
+// Close stops accepting new messages and blocks until the background
+// goroutine has drained all pending secondary deliveries.
+//
+// Close is idempotent; subsequent calls return immediately.
+func (tw *AsyncTeeWriter) Close() domerr.Result[model.Unit] {
+	tw.closeOnce.Do(func() {
+		close(tw.queue)
+	})
+	<-tw.done
+	return domerr.Ok(model.UnitValue)
+}
+
+// SecondaryErrors returns the number of failed secondary deliveries so far.
+// After Close it reflects the final count for the writer's lifetime.
+func (tw *AsyncTeeWriter) SecondaryErrors() int {
+	return int(tw.secErrs.Load())
+}
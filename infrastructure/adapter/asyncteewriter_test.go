@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// slowWriter records messages after a small delay, simulating a slow remote
+// sink. Safe for concurrent use.
+type slowWriter struct {
+	mu       sync.Mutex
+	messages []string
+	delay    time.Duration
+}
+
+func (sw *slowWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	time.Sleep(sw.delay)
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	sw.messages = append(sw.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+func (sw *slowWriter) captured() []string {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return append([]string(nil), sw.messages...)
+}
+
+// alwaysFailWriter returns an InfrastructureError for every write.
+type alwaysFailWriter struct{}
+
+func (alwaysFailWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](apperr.NewInfrastructureError("secondary down"))
+}
+
+// TestInfrastructureAdapterAsyncTeeWriter tests the AsyncTeeWriter adapter.
+func TestInfrastructureAdapterAsyncTeeWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.AsyncTeeWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Primary output is written synchronously
+	// ========================================================================
+
+	var primaryBuf bytes.Buffer
+	secondary := &slowWriter{delay: 5 * time.Millisecond}
+	tee := NewAsyncTeeWriter(NewWriter(&primaryBuf), secondary)
+
+	r1 := tee.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Write - primary result is Ok", r1.IsOk())
+	tf.RunTest("Write - primary output appears immediately",
+		strings.Contains(primaryBuf.String(), "Hello, Alice!"))
+
+	// ========================================================================
+	// Test: Close drains pending secondary deliveries
+	// ========================================================================
+
+	r2 := tee.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Write second - primary result is Ok", r2.IsOk())
+
+	r3 := tee.Close()
+	tf.RunTest("Close - IsOk returns true", r3.IsOk())
+
+	delivered := secondary.captured()
+	tf.RunTest("Close - secondary received all messages", len(delivered) == 2)
+	tf.RunTest("Close - secondary preserved order",
+		len(delivered) == 2 && delivered[0] == "Hello, Alice!" && delivered[1] == "Hello, Bob!")
+	tf.RunTest("Close - no secondary errors counted", tee.SecondaryErrors() == 0)
+
+	// ========================================================================
+	// Test: Secondary errors are counted, not returned
+	// ========================================================================
+
+	var primaryBuf2 bytes.Buffer
+	tee2 := NewAsyncTeeWriter(NewWriter(&primaryBuf2), alwaysFailWriter{})
+
+	r4 := tee2.Write(ctx, "Hello, Carol!")
+	tf.RunTest("Failing secondary - primary result is still Ok", r4.IsOk())
+
+	tee2.Close()
+	tf.RunTest("Failing secondary - error was counted", tee2.SecondaryErrors() == 1)
+	tf.RunTest("Failing secondary - primary output unaffected",
+		strings.Contains(primaryBuf2.String(), "Hello, Carol!"))
+
+	// ========================================================================
+	// Test: Close is idempotent
+	// ========================================================================
+
+	r5 := tee2.Close()
+	tf.RunTest("Close twice - second call IsOk", r5.IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
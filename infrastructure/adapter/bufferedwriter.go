@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Buffered output adapter
+
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// BufferedWriter is an infrastructure adapter that buffers messages in
+// memory and writes them to the underlying io.Writer in larger chunks.
+//
+// Use this for high-volume output (files, pipes) where per-line syscalls
+// dominate. Output is NOT visible downstream until the buffer fills or
+// Flush/Close is called.
+//
+// Lifecycle:
+//   - Create with NewBufferedWriter (bufio default size) or
+//     NewBufferedWriterSize (explicit size in bytes)
+//   - Write messages via the WriterPort contract
+//   - Call Flush (or Close) before the program exits to avoid losing
+//     buffered output
+//
+// Implements: outbound.WriterPort
+type BufferedWriter struct {
+	bw *bufio.Writer
+}
+
+// NewBufferedWriter creates a BufferedWriter with bufio's default buffer size.
+func NewBufferedWriter(w io.Writer) *BufferedWriter {
+	return &BufferedWriter{bw: bufio.NewWriter(w)}
+}
+
+// NewBufferedWriterSize creates a BufferedWriter with an explicit buffer
+// size in bytes. Size must be positive; bufio enforces a minimum internally.
+func NewBufferedWriterSize(w io.Writer, size int) *BufferedWriter {
+	return &BufferedWriter{bw: bufio.NewWriterSize(w, size)}
+}
+
+// Write appends the message (plus a trailing newline) to the buffer,
+// flushing to the underlying writer only when the buffer fills.
+//
+// Contract:
+//   - ctx parameter carries cancellation and deadline signals
+//   - Returns Ok(Unit) on success
+//   - Returns Err(InfrastructureError) on write failure or cancellation
+func (bw *BufferedWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	// Check for context cancellation before buffering
+	select {
+	case <-ctx.Done():
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("buffered write cancelled: %v", ctx.Err())))
+	default:
+		// Context is still active, proceed
+	}
+
+	_, err := fmt.Fprintln(bw.bw, message)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("buffered write failed: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+//
+// Contract:
+//   - Returns Ok(Unit) when the buffer was drained
+//   - Returns Err(InfrastructureError) if the underlying write fails
+func (bw *BufferedWriter) Flush() domerr.Result[model.Unit] {
+	if err := bw.bw.Flush(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("buffered flush failed: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Close flushes the buffer. The underlying io.Writer is NOT closed; the
+// caller retains ownership of it.
+func (bw *BufferedWriter) Close() domerr.Result[model.Unit] {
+	return bw.Flush()
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterBufferedWriter tests the BufferedWriter adapter.
+func TestInfrastructureAdapterBufferedWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.BufferedWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Small writes stay buffered until Flush
+	// ========================================================================
+
+	var buf bytes.Buffer
+	writer := NewBufferedWriterSize(&buf, 4096)
+
+	r1 := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Write - IsOk returns true", r1.IsOk())
+	tf.RunTest("Write - output still buffered", buf.Len() == 0)
+
+	r2 := writer.Flush()
+	tf.RunTest("Flush - IsOk returns true", r2.IsOk())
+	tf.RunTest("Flush - output delivered", buf.String() == "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Tiny buffer flushes automatically when full
+	// ========================================================================
+
+	var buf2 bytes.Buffer
+	tiny := NewBufferedWriterSize(&buf2, 16)
+	tiny.Write(ctx, "0123456789")
+	tiny.Write(ctx, "abcdefghij")
+	tf.RunTest("Tiny buffer - spilled to underlying writer", buf2.Len() > 0)
+	tiny.Flush()
+	tf.RunTest("Tiny buffer - all output after Flush",
+		buf2.String() == "0123456789\nabcdefghij\n")
+
+	// ========================================================================
+	// Test: Close flushes remaining output
+	// ========================================================================
+
+	var buf3 bytes.Buffer
+	writer3 := NewBufferedWriter(&buf3)
+	writer3.Write(ctx, "Hello, Bob!")
+	r3 := writer3.Close()
+	tf.RunTest("Close - IsOk returns true", r3.IsOk())
+	tf.RunTest("Close - output delivered", buf3.String() == "Hello, Bob!\n")
+
+	// ========================================================================
+	// Test: Cancelled context rejects the write
+	// ========================================================================
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r4 := writer3.Write(cancelledCtx, "ignored")
+	tf.RunTest("Cancelled context - IsError returns true", r4.IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -134,7 +134,9 @@ func NewWriter(w io.Writer) *ConsoleWriter {
 //
 // Context Handling:
 //   - Checks ctx.Done() before performing I/O
-//   - Returns InfrastructureError if context is cancelled
+//   - Races the I/O itself against ctx.Done() so a blocking io.Writer
+//     cannot hang the caller past the deadline
+//   - Returns TimeoutError if context fires first
 //   - Enables graceful shutdown and timeout support
 //
 // Error Handling:
@@ -142,47 +144,72 @@ func NewWriter(w io.Writer) *ConsoleWriter {
 //   - Maps all io.Writer errors to InfrastructureError
 //   - Includes original error message for debugging
 //
+// Goroutine Note:
+//   - The I/O runs in a background goroutine so Write can abandon it when
+//     the context fires. An abandoned write may still complete later (the
+//     bytes may yet reach the destination); the goroutine exits once the
+//     underlying writer returns, so nothing leaks as long as the writer
+//     eventually unblocks.
+//
 // Contract:
 //   - ctx parameter carries cancellation and deadline signals
 //   - message can be any string
 //   - Returns Ok(Unit) on success
-//   - Returns Err(InfrastructureError) on I/O failure, panic, or cancellation
+//   - Returns Err(InfrastructureError) on I/O failure or panic
+//   - Returns Err(TimeoutError) on cancellation, before or during the write
 //   - Never panics (panics are caught and converted to Err)
-func (cw *ConsoleWriter) Write(ctx context.Context, message string) (result domerr.Result[model.Unit]) {
-	// Recover from any panics and convert to InfrastructureError
-	// This ensures NO panics escape across the infrastructure boundary
-	// Pattern: Infrastructure adapters are the "exception boundary" where
-	// all panics/exceptions must be caught and converted to Result errors
-	defer func() {
-		if r := recover(); r != nil {
-			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
-				fmt.Sprintf("write panicked: %v", r)))
-		}
-	}()
-
-	// Check for context cancellation before I/O
-	// This is important for long-running operations or network writers
+func (cw *ConsoleWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	// Check for context cancellation before starting any I/O
+	// This keeps the common already-cancelled case cheap (no goroutine)
 	select {
 	case <-ctx.Done():
-		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+		// Cancellation is a TimeoutError so callers can tell an aborted
+		// write from a genuine I/O failure
+		return domerr.Err[model.Unit](apperr.NewTimeoutError(
 			fmt.Sprintf("write cancelled: %v", ctx.Err())))
 	default:
 		// Context is still active, proceed with I/O
 	}
 
-	// Perform the I/O operation using the injected writer
-	// fmt.Fprintln handles the newline and returns any write errors
-	_, err := fmt.Fprintln(cw.w, message)
-	if err != nil {
-		// Map the I/O error to a domain InfrastructureError
-		// This keeps infrastructure concerns (specific error types)
-		// from leaking into application/domain layers
-		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
-			fmt.Sprintf("write failed: %v", err)))
-	}
+	// Perform the I/O in a goroutine and race it against the context, so a
+	// blocking writer (e.g. a stalled network sink) cannot hold the caller
+	// past its deadline. The buffered channel lets the goroutine finish and
+	// exit even when the result is abandoned below.
+	done := make(chan domerr.Result[model.Unit], 1)
+	go func() {
+		// Recover from any panics and convert to InfrastructureError
+		// This ensures NO panics escape across the infrastructure boundary
+		// Pattern: Infrastructure adapters are the "exception boundary" where
+		// all panics/exceptions must be caught and converted to Result errors
+		defer func() {
+			if r := recover(); r != nil {
+				done <- domerr.Err[model.Unit](apperr.NewInfrastructureError(
+					fmt.Sprintf("write panicked: %v", r)))
+			}
+		}()
+
+		// fmt.Fprintln handles the newline and returns any write errors
+		_, err := fmt.Fprintln(cw.w, message)
+		if err != nil {
+			// Map the I/O error to a domain InfrastructureError
+			// This keeps infrastructure concerns (specific error types)
+			// from leaking into application/domain layers
+			done <- domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("write failed: %v", err)))
+			return
+		}
+		done <- domerr.Ok(model.UnitValue)
+	}()
 
-	// Success case - return Unit to indicate completion
-	return domerr.Ok(model.UnitValue)
+	select {
+	case result := <-done:
+		return result
+	case <-ctx.Done():
+		// The background write is abandoned, not interrupted: it may still
+		// complete after this returns. See the Goroutine Note above.
+		return domerr.Err[model.Unit](apperr.NewTimeoutError(
+			fmt.Sprintf("write cancelled: %v", ctx.Err())))
+	}
 }
 
 // NewConsoleWriter creates a ConsoleWriter that writes to standard output.
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// failingIOWriter is an io.Writer whose writes always fail.
+type failingIOWriter struct{}
+
+func (failingIOWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("simulated I/O failure")
+}
+
+// TestInfrastructureAdapterConsoleWriterTimeout tests the cancellation kind.
+func TestInfrastructureAdapterConsoleWriterTimeout(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.ConsoleWriter.Timeout")
+
+	// ========================================================================
+	// Test: A cancelled context aborts the write with TimeoutError
+	// ========================================================================
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r1 := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Cancelled context - error returned", r1.IsError())
+	tf.RunTest("Cancelled context - kind is TimeoutError",
+		r1.IsError() && r1.ErrorInfo().Kind == apperr.TimeoutError)
+	tf.RunTest("Cancelled context - nothing written", buf.Len() == 0)
+	tf.RunTest("Cancelled context - message explains the abort",
+		r1.IsError() && strings.Contains(r1.ErrorInfo().Message, "cancelled"))
+
+	// ========================================================================
+	// Test: An expired deadline yields the same kind
+	// ========================================================================
+
+	deadlineCtx, cancel2 := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel2()
+	<-deadlineCtx.Done()
+	r2 := writer.Write(deadlineCtx, "Hello, Bob!")
+	tf.RunTest("Expired deadline - kind is TimeoutError",
+		r2.IsError() && r2.ErrorInfo().Kind == apperr.TimeoutError)
+
+	// ========================================================================
+	// Test: A live context still writes and genuine failures stay infrastructure
+	// ========================================================================
+
+	r3 := writer.Write(context.Background(), "Hello, Carol!")
+	tf.RunTest("Live context - write succeeds",
+		r3.IsOk() && buf.String() == "Hello, Carol!\n")
+
+	failing := NewWriter(failingIOWriter{})
+	r4 := failing.Write(context.Background(), "Hello, Dave!")
+	tf.RunTest("I/O failure - kind stays InfrastructureError",
+		r4.IsError() && r4.ErrorInfo().Kind == apperr.InfrastructureError)
+
+	// ========================================================================
+	// Test: A writer that blocks mid-write cannot hold the caller past the
+	// deadline; Write abandons it and returns promptly
+	// ========================================================================
+
+	release := make(chan struct{})
+	blocked := NewWriter(&blockingIOWriter{release: release})
+	blockCtx, cancel3 := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel3()
+
+	start := time.Now()
+	r5 := blocked.Write(blockCtx, "Hello, Eve!")
+	elapsed := time.Since(start)
+	close(release) // unblock the abandoned background write so it can exit
+
+	tf.RunTest("Blocking writer - error returned", r5.IsError())
+	tf.RunTest("Blocking writer - kind is TimeoutError",
+		r5.IsError() && r5.ErrorInfo().Kind == apperr.TimeoutError)
+	tf.RunTest("Blocking writer - returned promptly, not after the block",
+		elapsed < 2*time.Second)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// blockingIOWriter is an io.Writer that blocks until release is closed,
+// simulating a stalled network sink.
+type blockingIOWriter struct {
+	release chan struct{}
+}
+
+func (w *blockingIOWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return len(p), nil
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Gzip-compressing output adapter
+
+package adapter
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// GzipWriter is an infrastructure adapter that compresses messages with gzip
+// before writing them to an underlying io.Writer.
+//
+// Use this for writing large greeting logs efficiently. Each message is
+// written as one line (newline-terminated) into a single gzip stream.
+//
+// Lifecycle:
+//   - Create with NewGzipWriter
+//   - Write messages via the WriterPort contract
+//   - Call Close when done to flush and terminate the gzip stream; the
+//     compressed output is not valid until Close has been called
+//
+// Design Pattern: Adapter (decorating io.Writer with compression)
+//
+// Implements: outbound.WriterPort
+type GzipWriter struct {
+	gz *gzip.Writer
+}
+
+// NewGzipWriter creates a GzipWriter that compresses output into w.
+//
+// Usage:
+//
+//	var buf bytes.Buffer
+//	writer := adapter.NewGzipWriter(&buf)
+//	writer.Write(ctx, "Hello, Alice!")
+//	writer.Close()
+//	// buf now holds the complete gzip stream
+func NewGzipWriter(w io.Writer) *GzipWriter {
+	return &GzipWriter{gz: gzip.NewWriter(w)}
+}
+
+// Write compresses the message (plus a trailing newline) into the gzip stream.
+//
+// Contract:
+//   - ctx parameter carries cancellation and deadline signals
+//   - Returns Ok(Unit) on success
+//   - Returns Err(InfrastructureError) on write failure or cancellation
+//   - Never panics (panics are caught and converted to Err)
+func (gw *GzipWriter) Write(ctx context.Context, message string) (result domerr.Result[model.Unit]) {
+	// Recover from any panics and convert to InfrastructureError
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("gzip write panicked: %v", r)))
+		}
+	}()
+
+	// Check for context cancellation before I/O
+	select {
+	case <-ctx.Done():
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("gzip write cancelled: %v", ctx.Err())))
+	default:
+		// Context is still active, proceed with I/O
+	}
+
+	// Compress the message as one newline-terminated line
+	_, err := fmt.Fprintln(gw.gz, message)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("gzip write failed: %v", err)))
+	}
+
+	// Success case - return Unit to indicate completion
+	return domerr.Ok(model.UnitValue)
+}
+
+// Close flushes any buffered compressed data and closes the gzip stream.
+//
+// The underlying io.Writer is NOT closed; the caller retains ownership of it.
+// After Close, further Write calls return an InfrastructureError.
+//
+// Contract:
+//   - Returns Ok(Unit) when the stream was flushed and terminated
+//   - Returns Err(InfrastructureError) if the gzip footer could not be written
+func (gw *GzipWriter) Close() domerr.Result[model.Unit] {
+	if err := gw.gz.Close(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("gzip close failed: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterGzipWriter tests the GzipWriter adapter.
+func TestInfrastructureAdapterGzipWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.GzipWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Write then Close produces a valid gzip stream with the lines
+	// ========================================================================
+
+	var buf bytes.Buffer
+	writer := NewGzipWriter(&buf)
+
+	r1 := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Write first message - IsOk returns true", r1.IsOk())
+
+	r2 := writer.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Write second message - IsOk returns true", r2.IsOk())
+
+	r3 := writer.Close()
+	tf.RunTest("Close - IsOk returns true", r3.IsOk())
+
+	// Gunzip the captured bytes and verify the original lines
+	gz, err := gzip.NewReader(&buf)
+	tf.RunTestWithError("Gunzip - stream is valid gzip", err)
+	if err == nil {
+		decompressed, readErr := io.ReadAll(gz)
+		tf.RunTestWithError("Gunzip - read all succeeds", readErr)
+		tf.RunTest("Gunzip - original lines recovered",
+			string(decompressed) == "Hello, Alice!\nHello, Bob!\n")
+	}
+
+	// ========================================================================
+	// Test: Write with cancelled context returns InfrastructureError
+	// ========================================================================
+
+	var buf2 bytes.Buffer
+	writer2 := NewGzipWriter(&buf2)
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r4 := writer2.Write(cancelledCtx, "should not be written")
+	tf.RunTest("Write cancelled context - IsError returns true", r4.IsError())
+
+	// ========================================================================
+	// Test: Empty stream (Close with no writes) is still valid gzip
+	// ========================================================================
+
+	var buf3 bytes.Buffer
+	writer3 := NewGzipWriter(&buf3)
+	r5 := writer3.Close()
+	tf.RunTest("Close without writes - IsOk returns true", r5.IsOk())
+
+	gz3, err3 := gzip.NewReader(&buf3)
+	tf.RunTestWithError("Close without writes - valid gzip stream", err3)
+	if err3 == nil {
+		data, _ := io.ReadAll(gz3)
+		tf.RunTest("Close without writes - empty payload", len(data) == 0)
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: In-memory spy writer for tests
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MemoryWriter is a test spy implementing WriterPort: it records every
+// message in memory instead of producing output, so tests can assert call
+// counts and exact messages without parsing a bytes.Buffer.
+//
+// FailAfter arms simulated failure, letting tests drive error paths
+// through real adapter stacks (BufferedWriter, MultiWriter, ...) without
+// a custom double per package.
+//
+// The zero value is ready to use; all methods are safe for concurrent use.
+//
+// Implements: outbound.WriterPort
+type MemoryWriter struct {
+	mu       sync.Mutex
+	messages []string
+	calls    int
+	failAt   int // 1-based call number that fails; 0 means never
+}
+
+// compile-time interface check
+var _ outbound.WriterPort = (*MemoryWriter)(nil)
+
+// NewMemoryWriter creates an empty MemoryWriter.
+//
+// Usage:
+//
+//	spy := adapter.NewMemoryWriter()
+//	uc := usecase.NewGreetUseCase[*adapter.MemoryWriter](spy)
+func NewMemoryWriter() *MemoryWriter {
+	return &MemoryWriter{}
+}
+
+// FailAfter arms the writer to fail the nth Write call (1-based) with an
+// InfrastructureError; calls before and after the nth succeed normally.
+// Non-positive n disarms. Failing calls still count toward CallCount but
+// their message is not recorded.
+func (mw *MemoryWriter) FailAfter(n int) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	if n <= 0 {
+		mw.failAt = 0
+		return
+	}
+	mw.failAt = n
+}
+
+// Write records the message, or fails if this is the armed call number.
+func (mw *MemoryWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	mw.calls++
+	if mw.failAt > 0 && mw.calls == mw.failAt {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("simulated failure on write %d", mw.calls)))
+	}
+	mw.messages = append(mw.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// Messages returns a copy of every successfully recorded message in write
+// order.
+func (mw *MemoryWriter) Messages() []string {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	out := make([]string, len(mw.messages))
+	copy(out, mw.messages)
+	return out
+}
+
+// CallCount returns how many times Write has been called, including calls
+// that failed.
+func (mw *MemoryWriter) CallCount() int {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	return mw.calls
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterMemoryWriter tests the in-memory spy writer.
+func TestInfrastructureAdapterMemoryWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.MemoryWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Messages are captured in write order
+	// ========================================================================
+
+	spy := NewMemoryWriter()
+	tf.RunTest("Capture - first write Ok", spy.Write(ctx, "Hello, Alice!").IsOk())
+	tf.RunTest("Capture - second write Ok", spy.Write(ctx, "Hello, Bob!").IsOk())
+
+	messages := spy.Messages()
+	tf.RunTest("Capture - both messages recorded in order",
+		len(messages) == 2 && messages[0] == "Hello, Alice!" && messages[1] == "Hello, Bob!")
+	tf.RunTest("Capture - call count matches", spy.CallCount() == 2)
+
+	// ========================================================================
+	// Test: Messages returns a copy, not the backing slice
+	// ========================================================================
+
+	messages[0] = "mutated"
+	tf.RunTest("Isolation - mutating the copy leaves the spy intact",
+		spy.Messages()[0] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: FailAfter fails exactly the armed call with InfrastructureError
+	// ========================================================================
+
+	failing := NewMemoryWriter()
+	failing.FailAfter(2)
+	tf.RunTest("FailAfter - call 1 succeeds", failing.Write(ctx, "one").IsOk())
+
+	r2 := failing.Write(ctx, "two")
+	tf.RunTest("FailAfter - call 2 fails", r2.IsError())
+	tf.RunTest("FailAfter - kind is InfrastructureError",
+		r2.IsError() && r2.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("FailAfter - message names the failing call",
+		r2.IsError() && strings.Contains(r2.ErrorInfo().Message, "write 2"))
+
+	tf.RunTest("FailAfter - call 3 succeeds again", failing.Write(ctx, "three").IsOk())
+	tf.RunTest("FailAfter - failed message not recorded",
+		len(failing.Messages()) == 2 && failing.Messages()[1] == "three")
+	tf.RunTest("FailAfter - failed call still counted", failing.CallCount() == 3)
+
+	// ========================================================================
+	// Test: Non-positive FailAfter disarms
+	// ========================================================================
+
+	failing.FailAfter(0)
+	tf.RunTest("Disarm - writes succeed after disarming",
+		failing.Write(ctx, "four").IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Fan-out adapter writing each message to several WriterPorts
+
+package adapter
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MultiWriter fans each written message out to several WriterPorts in
+// order, e.g. stdout plus a log file.
+//
+// Failure policy: best effort, first error reported. A failing writer
+// never prevents later writers from receiving the message - every writer
+// is always attempted - but the first error encountered is the one
+// returned, so callers still learn that the fan-out was incomplete.
+//
+// Implements: outbound.WriterPort
+type MultiWriter struct {
+	writers []outbound.WriterPort
+}
+
+// NewMultiWriter creates a MultiWriter fanning out to ws in the given
+// order.
+//
+// Usage:
+//
+//	writer := adapter.NewMultiWriter(consoleWriter, fileWriter)
+func NewMultiWriter(ws ...outbound.WriterPort) *MultiWriter {
+	return &MultiWriter{writers: ws}
+}
+
+// Write delivers the message to every underlying writer in order,
+// returning Ok only when all succeeded, otherwise the first error.
+func (mw *MultiWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	result := domerr.Ok(model.UnitValue)
+	for _, w := range mw.writers {
+		if r := w.Write(ctx, message); r.IsError() && result.IsOk() {
+			result = r
+		}
+	}
+	return result
+}
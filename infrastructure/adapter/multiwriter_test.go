@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// namedFailWriter fails every write with its configured error message.
+type namedFailWriter struct {
+	message string
+}
+
+func (fw *namedFailWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](apperr.NewInfrastructureError(fw.message))
+}
+
+// TestInfrastructureAdapterMultiWriter tests the fan-out writer.
+func TestInfrastructureAdapterMultiWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.MultiWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: All writers receive each message, in order
+	// ========================================================================
+
+	first := &recordingWriter{}
+	second := &recordingWriter{}
+	mw := NewMultiWriter(first, second)
+
+	r1 := mw.Write(ctx, "Hello, Alice!")
+	tf.RunTest("All healthy - returns Ok", r1.IsOk())
+	tf.RunTest("All healthy - both writers received the message",
+		len(first.messages) == 1 && len(second.messages) == 1 &&
+			first.messages[0] == "Hello, Alice!" && second.messages[0] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: A failing writer does not starve the rest (best effort)
+	// ========================================================================
+
+	a := &recordingWriter{}
+	b := &recordingWriter{}
+	failing := &namedFailWriter{message: "disk full"}
+	mixed := NewMultiWriter(a, failing, b)
+
+	r2 := mixed.Write(ctx, "Hello, Bob!")
+	tf.RunTest("One failing - error propagated", r2.IsError())
+	tf.RunTest("One failing - first error reported",
+		r2.IsError() && r2.ErrorInfo().Message == "disk full")
+	tf.RunTest("One failing - writers before the failure still wrote",
+		len(a.messages) == 1 && a.messages[0] == "Hello, Bob!")
+	tf.RunTest("One failing - writers after the failure still wrote",
+		len(b.messages) == 1 && b.messages[0] == "Hello, Bob!")
+
+	// ========================================================================
+	// Test: With several failures the first error wins
+	// ========================================================================
+
+	double := NewMultiWriter(&namedFailWriter{message: "first"}, &namedFailWriter{message: "second"})
+	r3 := double.Write(ctx, "Hello, Carol!")
+	tf.RunTest("Two failing - first error wins",
+		r3.IsError() && r3.ErrorInfo().Message == "first")
+
+	// ========================================================================
+	// Test: An empty fan-out succeeds trivially
+	// ========================================================================
+
+	tf.RunTest("No writers - Ok", NewMultiWriter().Write(ctx, "x").IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Dynamic prefixing decorator for WriterPorts
+
+package adapter
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// PrefixWriter is a WriterPort decorator that prepends the result of a
+// prefix function to every message before delegating, so operators can tag
+// each greeting line (an RFC3339 timestamp, a hostname, a log level)
+// without touching the use case. The function is evaluated per write, so
+// dynamic prefixes stay current.
+//
+// Composes freely with the other decorators (MultiWriter, BufferedWriter).
+//
+// Implements: outbound.WriterPort
+type PrefixWriter struct {
+	inner    outbound.WriterPort
+	prefixFn func() string
+}
+
+// NewPrefixWriter creates a PrefixWriter prepending prefixFn() to each
+// message written to inner.
+//
+// Usage:
+//
+//	writer := adapter.NewPrefixWriter(consoleWriter, func() string {
+//	    return time.Now().UTC().Format(time.RFC3339) + " "
+//	})
+func NewPrefixWriter(inner outbound.WriterPort, prefixFn func() string) *PrefixWriter {
+	return &PrefixWriter{
+		inner:    inner,
+		prefixFn: prefixFn,
+	}
+}
+
+// Write delegates to the inner writer with the prefix prepended.
+func (pw *PrefixWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return pw.inner.Write(ctx, pw.prefixFn()+message)
+}
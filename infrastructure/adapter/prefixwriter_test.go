@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterPrefixWriter tests the dynamic prefix decorator.
+func TestInfrastructureAdapterPrefixWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.PrefixWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: A fixed-clock timestamp prefix yields exact output
+	// ========================================================================
+
+	fixed := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	var buf bytes.Buffer
+	writer := NewPrefixWriter(NewWriter(&buf), func() string {
+		return fixed.Format(time.RFC3339) + " "
+	})
+
+	r1 := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Timestamp prefix - write succeeds", r1.IsOk())
+	tf.RunTest("Timestamp prefix - exact output",
+		buf.String() == "2025-01-02T15:04:05Z Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: The prefix function is evaluated per write
+	// ========================================================================
+
+	count := 0
+	counted := NewPrefixWriter(&recordingWriter{}, func() string {
+		count++
+		return "[tag] "
+	})
+	counted.Write(ctx, "one")
+	counted.Write(ctx, "two")
+	tf.RunTest("Per-write evaluation - called once per message", count == 2)
+
+	// ========================================================================
+	// Test: Composes with MultiWriter
+	// ========================================================================
+
+	a := &recordingWriter{}
+	b := &recordingWriter{}
+	fanout := NewPrefixWriter(NewMultiWriter(a, b), func() string { return "greeter: " })
+	fanout.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Composed - both sinks see the prefixed message",
+		len(a.messages) == 1 && a.messages[0] == "greeter: Hello, Bob!" &&
+			len(b.messages) == 1 && b.messages[0] == "greeter: Hello, Bob!")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Terminal punctuation enforcing decorator
+
+package adapter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// defaultTerminator is appended by PunctuationWriter when a message lacks
+// terminal punctuation and no custom terminator was configured.
+const defaultTerminator = "!"
+
+// terminalPunctuation lists the characters accepted as an existing message
+// terminator; messages already ending in one pass through unchanged.
+const terminalPunctuation = "!.?"
+
+// PunctuationWriter is a WriterPort decorator that enforces a terminal
+// punctuation policy: messages without terminal punctuation get the
+// configured terminator appended before being forwarded.
+//
+// Use this when output is assembled from mixed sources with inconsistent
+// style and a uniform appearance is wanted.
+//
+// Implements: outbound.WriterPort
+type PunctuationWriter struct {
+	next       outbound.WriterPort
+	terminator string
+}
+
+// NewPunctuationWriter creates a PunctuationWriter appending "!" to
+// unterminated messages.
+//
+// Usage:
+//
+//	writer := adapter.NewPunctuationWriter(consoleWriter)
+//	writer.Write(ctx, "Hello, Alice") // forwards "Hello, Alice!"
+func NewPunctuationWriter(next outbound.WriterPort) *PunctuationWriter {
+	return NewPunctuationWriterWith(next, defaultTerminator)
+}
+
+// NewPunctuationWriterWith creates a PunctuationWriter with a custom
+// terminator (e.g. "." for formal output).
+func NewPunctuationWriterWith(next outbound.WriterPort, terminator string) *PunctuationWriter {
+	return &PunctuationWriter{
+		next:       next,
+		terminator: terminator,
+	}
+}
+
+// Write forwards the message, appending the configured terminator when the
+// message does not already end in terminal punctuation ("!", "." or "?").
+// Empty messages are forwarded unchanged.
+func (pw *PunctuationWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if message != "" && !strings.ContainsAny(message[len(message)-1:], terminalPunctuation) {
+		message += pw.terminator
+	}
+	return pw.next.Write(ctx, message)
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterPunctuationWriter tests the punctuation decorator.
+func TestInfrastructureAdapterPunctuationWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.PunctuationWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Messages already ending in "!" pass through unchanged
+	// ========================================================================
+
+	sink := &recordingWriter{}
+	pw := NewPunctuationWriter(sink)
+
+	r1 := pw.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Existing bang - Write returns Ok", r1.IsOk())
+	tf.RunTest("Existing bang - message unchanged",
+		len(sink.messages) == 1 && sink.messages[0] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Messages ending in "." pass through unchanged
+	// ========================================================================
+
+	pw.Write(ctx, "Goodbye, Bob.")
+	tf.RunTest("Existing period - message unchanged",
+		len(sink.messages) == 2 && sink.messages[1] == "Goodbye, Bob.")
+
+	// ========================================================================
+	// Test: Unterminated messages get the default "!" appended
+	// ========================================================================
+
+	pw.Write(ctx, "Hello, Carol")
+	tf.RunTest("No punctuation - default terminator appended",
+		len(sink.messages) == 3 && sink.messages[2] == "Hello, Carol!")
+
+	// ========================================================================
+	// Test: Custom terminator is used when configured
+	// ========================================================================
+
+	formalSink := &recordingWriter{}
+	formal := NewPunctuationWriterWith(formalSink, ".")
+	formal.Write(ctx, "Hello, Dave")
+	tf.RunTest("Custom terminator - appended when missing",
+		len(formalSink.messages) == 1 && formalSink.messages[0] == "Hello, Dave.")
+
+	// ========================================================================
+	// Test: Empty messages are forwarded unchanged
+	// ========================================================================
+
+	pw.Write(ctx, "")
+	tf.RunTest("Empty message - forwarded unchanged",
+		len(sink.messages) == 4 && sink.messages[3] == "")
+
+	// ========================================================================
+	// Test: Downstream errors propagate
+	// ========================================================================
+
+	failing := NewPunctuationWriter(alwaysFailWriter{})
+	r2 := failing.Write(ctx, "Hello")
+	tf.RunTest("Failing sink - error propagates", r2.IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
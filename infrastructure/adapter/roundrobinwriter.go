@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Round-robin load-distributing composite writer
+
+package adapter
+
+import (
+	"context"
+	"sync/atomic"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// RoundRobinWriter distributes writes across a ring of inner WriterPorts:
+// each message goes to the next writer in rotation. When the chosen writer
+// fails, the message fails over to the following writers in ring order and
+// an error is returned only if every writer rejects that message.
+//
+// Contrast with MultiWriter, which sends every message to all sinks; the
+// round-robin ring sends each message to exactly one healthy sink, spreading
+// load instead of duplicating output.
+//
+// The rotation index is advanced atomically, so concurrent writers spread
+// fairly without a lock.
+//
+// Implements: outbound.WriterPort
+type RoundRobinWriter struct {
+	writers []outbound.WriterPort
+	next    atomic.Uint64
+}
+
+// NewRoundRobinWriter creates a RoundRobinWriter rotating over the given
+// writers in argument order. At least one writer is expected; with none,
+// every Write fails with an InfrastructureError.
+//
+// Usage:
+//
+//	ring := adapter.NewRoundRobinWriter(sinkA, sinkB, sinkC)
+func NewRoundRobinWriter(ws ...outbound.WriterPort) *RoundRobinWriter {
+	return &RoundRobinWriter{writers: ws}
+}
+
+// Write dispatches the message to the next writer in rotation, failing over
+// around the ring on error. Only the last failure is returned when the full
+// ring has been exhausted for this message.
+func (rr *RoundRobinWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if len(rr.writers) == 0 {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			"round-robin writer has no inner writers"))
+	}
+
+	start := int(rr.next.Add(1)-1) % len(rr.writers)
+
+	var lastErr domerr.ErrorType
+	for i := 0; i < len(rr.writers); i++ {
+		writer := rr.writers[(start+i)%len(rr.writers)]
+		result := writer.Write(ctx, message)
+		if result.IsOk() {
+			return result
+		}
+		lastErr = result.ErrorInfo()
+	}
+	return domerr.Err[model.Unit](lastErr)
+}
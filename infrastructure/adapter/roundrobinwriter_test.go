@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterRoundRobinWriter tests rotation and failover.
+func TestInfrastructureAdapterRoundRobinWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.RoundRobinWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Messages rotate across the ring in order
+	// ========================================================================
+
+	a := NewMemoryWriter()
+	b := NewMemoryWriter()
+	c := NewMemoryWriter()
+	ring := NewRoundRobinWriter(a, b, c)
+
+	for _, msg := range []string{"one", "two", "three", "four"} {
+		ring.Write(ctx, msg)
+	}
+	tf.RunTest("Rotation - first writer got 1st and 4th",
+		len(a.Messages()) == 2 && a.Messages()[0] == "one" && a.Messages()[1] == "four")
+	tf.RunTest("Rotation - second writer got 2nd",
+		len(b.Messages()) == 1 && b.Messages()[0] == "two")
+	tf.RunTest("Rotation - third writer got 3rd",
+		len(c.Messages()) == 1 && c.Messages()[0] == "three")
+
+	// ========================================================================
+	// Test: A failing writer is skipped and the message fails over
+	// ========================================================================
+
+	flaky := NewMemoryWriter()
+	flaky.FailAfter(1)
+	healthy := NewMemoryWriter()
+	failover := NewRoundRobinWriter(flaky, healthy)
+
+	r1 := failover.Write(ctx, "rescued")
+	tf.RunTest("Failover - write succeeds despite first writer failing", r1.IsOk())
+	tf.RunTest("Failover - healthy writer received the message",
+		len(healthy.Messages()) == 1 && healthy.Messages()[0] == "rescued")
+	tf.RunTest("Failover - failing writer was attempted first",
+		flaky.CallCount() == 1 && len(flaky.Messages()) == 0)
+
+	// ========================================================================
+	// Test: All writers failing yields an error
+	// ========================================================================
+
+	dead := NewRoundRobinWriter(
+		&namedFailWriter{message: "sink A down"},
+		&namedFailWriter{message: "sink B down"})
+	r2 := dead.Write(ctx, "lost")
+	tf.RunTest("Exhausted - error returned when every writer fails", r2.IsError())
+
+	// ========================================================================
+	// Test: An empty ring fails every write
+	// ========================================================================
+
+	empty := NewRoundRobinWriter()
+	tf.RunTest("Empty - write fails with no inner writers",
+		empty.Write(ctx, "nowhere").IsError())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Predicate-based routing output adapter
+
+package adapter
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// RoutingWriter inspects each message and routes it to one of two sinks:
+// messages matching the predicate go to the stderr sink, everything else
+// goes to the stdout sink.
+//
+// Use this for mixed output streams where error-like messages (e.g. those
+// starting with "Error:") should be separated from normal output without
+// the caller having to choose a writer per message.
+//
+// Implements: outbound.WriterPort
+type RoutingWriter struct {
+	stdout   outbound.WriterPort
+	stderr   outbound.WriterPort
+	toStderr func(string) bool
+}
+
+// NewRoutingWriter creates a RoutingWriter. Messages for which toStderr
+// returns true are written to the stderr sink; all others go to stdout.
+//
+// Usage:
+//
+//	router := adapter.NewRoutingWriter(outWriter, errWriter, func(msg string) bool {
+//	    return strings.HasPrefix(msg, "Error:")
+//	})
+func NewRoutingWriter(stdout, stderr outbound.WriterPort, toStderr func(string) bool) *RoutingWriter {
+	return &RoutingWriter{
+		stdout:   stdout,
+		stderr:   stderr,
+		toStderr: toStderr,
+	}
+}
+
+// Write routes the message to the sink selected by the predicate and
+// returns that sink's Result.
+func (rw *RoutingWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if rw.toStderr(message) {
+		return rw.stderr.Write(ctx, message)
+	}
+	return rw.stdout.Write(ctx, message)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// recordingWriter captures every message written to it.
+type recordingWriter struct {
+	messages []string
+}
+
+func (rw *recordingWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	rw.messages = append(rw.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestInfrastructureAdapterRoutingWriter tests the RoutingWriter adapter.
+func TestInfrastructureAdapterRoutingWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.RoutingWriter")
+	ctx := context.Background()
+
+	isError := func(msg string) bool { return strings.HasPrefix(msg, "Error:") }
+
+	// ========================================================================
+	// Test: Non-matching messages route to the stdout sink
+	// ========================================================================
+
+	outSink := &recordingWriter{}
+	errSink := &recordingWriter{}
+	router := NewRoutingWriter(outSink, errSink, isError)
+
+	r1 := router.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Normal message - Write returns Ok", r1.IsOk())
+	tf.RunTest("Normal message - routed to stdout sink",
+		len(outSink.messages) == 1 && outSink.messages[0] == "Hello, Alice!")
+	tf.RunTest("Normal message - stderr sink untouched", len(errSink.messages) == 0)
+
+	// ========================================================================
+	// Test: Matching messages route to the stderr sink
+	// ========================================================================
+
+	r2 := router.Write(ctx, "Error: invalid name")
+	tf.RunTest("Error message - Write returns Ok", r2.IsOk())
+	tf.RunTest("Error message - routed to stderr sink",
+		len(errSink.messages) == 1 && errSink.messages[0] == "Error: invalid name")
+	tf.RunTest("Error message - stdout sink unchanged", len(outSink.messages) == 1)
+
+	// ========================================================================
+	// Test: The selected sink's Result propagates to the caller
+	// ========================================================================
+
+	failRouter := NewRoutingWriter(&recordingWriter{}, alwaysFailWriter{}, isError)
+	r3 := failRouter.Write(ctx, "Error: boom")
+	tf.RunTest("Failing stderr sink - error propagates", r3.IsError())
+
+	r4 := failRouter.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Healthy stdout sink - Ok despite failing stderr sink", r4.IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
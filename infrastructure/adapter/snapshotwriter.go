@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Accumulating output adapter for snapshot testing
+
+package adapter
+
+import (
+	"context"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// SnapshotWriter accumulates every written message in memory and exposes the
+// concatenated output via Snapshot.
+//
+// This is a purpose-named alternative to wiring a bytes.Buffer through
+// NewWriter when the goal is snapshot-testing greeting output: consumers get
+// the full output stream as a single string without assembling the plumbing
+// themselves.
+//
+// Implements: outbound.WriterPort
+type SnapshotWriter struct {
+	sb strings.Builder
+}
+
+// NewSnapshotWriter creates an empty SnapshotWriter.
+//
+// Usage:
+//
+//	snap := adapter.NewSnapshotWriter()
+//	greeter := desktop.GreeterWithWriter[*adapter.SnapshotWriter](snap)
+//	// ... execute greetings ...
+//	output := snap.Snapshot()
+func NewSnapshotWriter() *SnapshotWriter {
+	return &SnapshotWriter{}
+}
+
+// Write appends the message (with a trailing newline, matching console
+// output) to the accumulated snapshot.
+func (sw *SnapshotWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	// Respect context cancellation like the other adapters
+	select {
+	case <-ctx.Done():
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			"write cancelled: " + ctx.Err().Error()))
+	default:
+	}
+
+	sw.sb.WriteString(message)
+	sw.sb.WriteString("\n")
+	return domerr.Ok(model.UnitValue)
+}
+
+// Snapshot returns the full concatenated output written so far.
+func (sw *SnapshotWriter) Snapshot() string {
+	return sw.sb.String()
+}
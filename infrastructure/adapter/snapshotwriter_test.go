@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterSnapshotWriter tests the SnapshotWriter adapter.
+func TestInfrastructureAdapterSnapshotWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SnapshotWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Empty writer yields empty snapshot
+	// ========================================================================
+
+	sw := NewSnapshotWriter()
+	tf.RunTest("New writer - snapshot is empty", sw.Snapshot() == "")
+
+	// ========================================================================
+	// Test: Several greetings accumulate in order
+	// ========================================================================
+
+	r1 := sw.Write(ctx, "Hello, Alice!")
+	r2 := sw.Write(ctx, "Hello, Bob!")
+	r3 := sw.Write(ctx, "Hello, Carol!")
+	tf.RunTest("Write - all writes return Ok", r1.IsOk() && r2.IsOk() && r3.IsOk())
+	tf.RunTest("Snapshot - cumulative output in order",
+		sw.Snapshot() == "Hello, Alice!\nHello, Bob!\nHello, Carol!\n")
+
+	// ========================================================================
+	// Test: Snapshot is stable across repeated calls
+	// ========================================================================
+
+	tf.RunTest("Snapshot - repeated call returns same output",
+		sw.Snapshot() == sw.Snapshot())
+
+	// ========================================================================
+	// Test: Cancelled context fails the write without mutating the snapshot
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	before := sw.Snapshot()
+	r4 := sw.Write(cancelled, "Hello, Dave!")
+	tf.RunTest("Cancelled context - Write returns error", r4.IsError())
+	tf.RunTest("Cancelled context - snapshot unchanged", sw.Snapshot() == before)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
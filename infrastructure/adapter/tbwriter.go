@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Output adapter that routes messages into go test logs
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// TBWriter routes every written message to a testing.TB via t.Log, so
+// greeting output shows up in `go test` logs alongside the assertions that
+// exercised it. Writes always succeed.
+//
+// Implements: outbound.WriterPort
+type TBWriter struct {
+	tb testing.TB
+}
+
+// NewTBWriter creates a TBWriter logging to tb.
+//
+// Usage:
+//
+//	writer := adapter.NewTBWriter(t)
+//	greeter := desktop.GreeterWithWriter[*adapter.TBWriter](writer)
+func NewTBWriter(tb testing.TB) *TBWriter {
+	return &TBWriter{tb: tb}
+}
+
+// Write logs the message to the wrapped testing.TB and returns Ok.
+func (tw *TBWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	tw.tb.Log(message)
+	return domerr.Ok(model.UnitValue)
+}
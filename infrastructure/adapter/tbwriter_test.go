@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// fakeTB is a testing.TB double that records every logged line, letting
+// tests assert what TBWriter would emit without polluting real test output.
+type fakeTB struct {
+	testing.TB
+	lines []string
+}
+
+func (f *fakeTB) Log(args ...any) {
+	f.lines = append(f.lines, fmt.Sprint(args...))
+}
+
+// TestInfrastructureAdapterTBWriter tests the TBWriter adapter.
+func TestInfrastructureAdapterTBWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.TBWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Each write logs one line and returns Ok
+	// ========================================================================
+
+	fake := &fakeTB{}
+	writer := NewTBWriter(fake)
+
+	r1 := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Write - returns Ok", r1.IsOk())
+	tf.RunTest("Write - message logged",
+		len(fake.lines) == 1 && fake.lines[0] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Writes accumulate in order
+	// ========================================================================
+
+	writer.Write(ctx, "Hello, Bob!")
+	writer.Write(ctx, "Hello, Carol!")
+	tf.RunTest("Multiple writes - all lines recorded", len(fake.lines) == 3)
+	tf.RunTest("Multiple writes - order preserved",
+		fake.lines[1] == "Hello, Bob!" && fake.lines[2] == "Hello, Carol!")
+
+	// ========================================================================
+	// Test: Wiring the real TB integrates output with the test log
+	// ========================================================================
+
+	real := NewTBWriter(t)
+	tf.RunTest("Real TB - write succeeds", real.Write(ctx, "TBWriter self-test").IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
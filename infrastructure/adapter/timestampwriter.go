@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Timestamp prefixing decorator for log correlation
+
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Clock supplies the current time, injectable so tests can pin it.
+// time.Now satisfies it directly.
+type Clock func() time.Time
+
+// TimestampWriter is a WriterPort decorator prefixing each message with the
+// current time rendered in a configurable layout, e.g.
+// "2025-01-02T15:04:05Z Hello, Alice!". Use it to correlate greeter output
+// with surrounding logs.
+//
+// Implements: outbound.WriterPort
+type TimestampWriter struct {
+	next   outbound.WriterPort
+	layout string
+	clock  Clock
+}
+
+// NewTimestampWriter creates a TimestampWriter rendering timestamps in the
+// given time.Format layout using the injected clock.
+//
+// Usage:
+//
+//	writer := adapter.NewTimestampWriter(consoleWriter, time.RFC3339, time.Now)
+func NewTimestampWriter(next outbound.WriterPort, layout string, clock Clock) *TimestampWriter {
+	return &TimestampWriter{
+		next:   next,
+		layout: layout,
+		clock:  clock,
+	}
+}
+
+// Write forwards the message prefixed with the formatted current time and a
+// single separating space.
+func (tw *TimestampWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return tw.next.Write(ctx, tw.clock().Format(tw.layout)+" "+message)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterTimestampWriter tests the timestamp decorator.
+func TestInfrastructureAdapterTimestampWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.TimestampWriter")
+	ctx := context.Background()
+
+	fixed := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return fixed }
+
+	// ========================================================================
+	// Test: RFC3339 layout produces the exact documented prefix
+	// ========================================================================
+
+	sink := &recordingWriter{}
+	writer := NewTimestampWriter(sink, time.RFC3339, clock)
+
+	r1 := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("RFC3339 - write succeeds", r1.IsOk())
+	tf.RunTest("RFC3339 - exact prefixed output",
+		len(sink.messages) == 1 && sink.messages[0] == "2025-01-02T15:04:05Z Hello, Alice!")
+
+	// ========================================================================
+	// Test: A custom layout renders accordingly
+	// ========================================================================
+
+	sink2 := &recordingWriter{}
+	writer2 := NewTimestampWriter(sink2, "15:04:05", clock)
+	writer2.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Time-only layout - exact prefixed output",
+		len(sink2.messages) == 1 && sink2.messages[0] == "15:04:05 Hello, Bob!")
+
+	// ========================================================================
+	// Test: An advancing clock is consulted per write
+	// ========================================================================
+
+	now := fixed
+	sink3 := &recordingWriter{}
+	writer3 := NewTimestampWriter(sink3, "15:04:05", func() time.Time { return now })
+	writer3.Write(ctx, "first")
+	now = now.Add(2 * time.Second)
+	writer3.Write(ctx, "second")
+	tf.RunTest("Advancing clock - each write freshly stamped",
+		len(sink3.messages) == 2 &&
+			sink3.messages[0] == "15:04:05 first" &&
+			sink3.messages[1] == "15:04:07 second")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
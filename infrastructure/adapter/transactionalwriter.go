@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Buffering decorator with commit/rollback semantics
+
+package adapter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// TransactionalWriter buffers every written message and only forwards them
+// to the inner writer - all of them, in order - when Commit is called.
+// Rollback discards the buffer instead. Either way the writer is empty
+// afterwards and can start a fresh transaction.
+//
+// Use this when a batch of greetings should appear all-or-nothing, e.g.
+// when a later name's validation failure should suppress earlier output.
+//
+// Implements: outbound.WriterPort
+type TransactionalWriter struct {
+	mu      sync.Mutex
+	next    outbound.WriterPort
+	pending []string
+}
+
+// NewTransactionalWriter creates an empty TransactionalWriter forwarding to
+// next on Commit.
+//
+// Usage:
+//
+//	tx := adapter.NewTransactionalWriter(consoleWriter)
+//	// ... writes buffer silently ...
+//	tx.Commit(ctx) // or tx.Rollback()
+func NewTransactionalWriter(next outbound.WriterPort) *TransactionalWriter {
+	return &TransactionalWriter{next: next}
+}
+
+// Write buffers the message; nothing reaches the inner writer until Commit.
+func (tw *TransactionalWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.pending = append(tw.pending, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// Commit forwards every buffered message to the inner writer in write
+// order, then clears the buffer. The first inner failure aborts the flush
+// and is returned; already-forwarded messages are not retracted, but the
+// unflushed remainder stays discarded so a retry never duplicates output.
+func (tw *TransactionalWriter) Commit(ctx context.Context) domerr.Result[model.Unit] {
+	tw.mu.Lock()
+	pending := tw.pending
+	tw.pending = nil
+	tw.mu.Unlock()
+
+	for _, message := range pending {
+		if result := tw.next.Write(ctx, message); result.IsError() {
+			return result
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Rollback discards every buffered message without touching the inner
+// writer.
+func (tw *TransactionalWriter) Rollback() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.pending = nil
+}
+
+// Pending returns the number of buffered, uncommitted messages.
+func (tw *TransactionalWriter) Pending() int {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return len(tw.pending)
+}
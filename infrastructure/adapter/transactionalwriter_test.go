@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterTransactionalWriter tests commit/rollback output.
+func TestInfrastructureAdapterTransactionalWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.TransactionalWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Writes buffer silently until Commit
+	// ========================================================================
+
+	sink := &recordingWriter{}
+	tx := NewTransactionalWriter(sink)
+
+	tf.RunTest("Write - returns Ok", tx.Write(ctx, "Hello, Alice!").IsOk())
+	tx.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Before commit - nothing forwarded", len(sink.messages) == 0)
+	tf.RunTest("Before commit - pending counted", tx.Pending() == 2)
+
+	// ========================================================================
+	// Test: Commit forwards everything in write order, then clears
+	// ========================================================================
+
+	tf.RunTest("Commit - returns Ok", tx.Commit(ctx).IsOk())
+	tf.RunTest("After commit - all messages forwarded in order",
+		len(sink.messages) == 2 &&
+			sink.messages[0] == "Hello, Alice!" &&
+			sink.messages[1] == "Hello, Bob!")
+	tf.RunTest("After commit - buffer empty", tx.Pending() == 0)
+
+	tx.Commit(ctx)
+	tf.RunTest("Second commit - nothing duplicated", len(sink.messages) == 2)
+
+	// ========================================================================
+	// Test: Rollback discards the buffer without touching the inner writer
+	// ========================================================================
+
+	sink2 := &recordingWriter{}
+	tx2 := NewTransactionalWriter(sink2)
+	tx2.Write(ctx, "Hello, Carol!")
+	tx2.Rollback()
+	tf.RunTest("After rollback - buffer empty", tx2.Pending() == 0)
+	tx2.Commit(ctx)
+	tf.RunTest("After rollback - nothing ever forwarded", len(sink2.messages) == 0)
+
+	// ========================================================================
+	// Test: A fresh transaction works after rollback
+	// ========================================================================
+
+	tx2.Write(ctx, "Hello, Dave!")
+	tx2.Commit(ctx)
+	tf.RunTest("Fresh transaction - only new message forwarded",
+		len(sink2.messages) == 1 && sink2.messages[0] == "Hello, Dave!")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Fluent builder composing writer decorators
+
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// RetryPolicy configures the retry decorator applied by WriterBuilder.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of write attempts (including the
+	// first). Values below 1 are treated as 1 (no retries).
+	MaxAttempts int
+
+	// Delay is an optional pause between attempts. Zero means retry
+	// immediately.
+	Delay time.Duration
+}
+
+// WriterBuilder composes writer decorators over a base WriterPort without
+// the hand-wired nesting that otherwise accumulates at the composition root.
+//
+// Decorators are applied in a FIXED order regardless of the order the
+// With methods are called, so stacks behave predictably:
+//
+//	prefix (outermost) -> timing -> retry -> base
+//
+// That is: the prefix is part of the message that timing measures and that
+// retry re-delivers on each attempt.
+//
+// Usage:
+//
+//	writer := adapter.NewWriterBuilder(base).
+//	    WithRetry(adapter.RetryPolicy{MaxAttempts: 3}).
+//	    WithPrefix("[greeter] ").
+//	    WithTiming(recordLatency).
+//	    Build()
+type WriterBuilder struct {
+	base      outbound.WriterPort
+	retry     *RetryPolicy
+	prefix    string
+	hasPrefix bool
+	timing    func(time.Duration)
+}
+
+// NewWriterBuilder creates a WriterBuilder over the given base writer.
+func NewWriterBuilder(base outbound.WriterPort) *WriterBuilder {
+	return &WriterBuilder{base: base}
+}
+
+// WithRetry adds a retry decorator that re-attempts failed writes according
+// to the policy. The last attempt's error is returned when all attempts fail.
+func (b *WriterBuilder) WithRetry(policy RetryPolicy) *WriterBuilder {
+	b.retry = &policy
+	return b
+}
+
+// WithPrefix adds a decorator that prepends the given prefix to every
+// message.
+func (b *WriterBuilder) WithPrefix(prefix string) *WriterBuilder {
+	b.prefix = prefix
+	b.hasPrefix = true
+	return b
+}
+
+// WithTiming adds a decorator that reports the duration of each write
+// (including any retries) to cb.
+func (b *WriterBuilder) WithTiming(cb func(time.Duration)) *WriterBuilder {
+	b.timing = cb
+	return b
+}
+
+// Build assembles the configured stack and returns it as a WriterPort.
+// The builder can be reused; Build takes a snapshot of the current settings.
+func (b *WriterBuilder) Build() outbound.WriterPort {
+	writer := b.base
+	if b.retry != nil {
+		writer = &retryWriter{next: writer, policy: *b.retry}
+	}
+	if b.timing != nil {
+		writer = &timingWriter{next: writer, report: b.timing}
+	}
+	if b.hasPrefix {
+		writer = &staticPrefixWriter{next: writer, prefix: b.prefix}
+	}
+	return writer
+}
+
+// retryWriter re-attempts failed writes per its RetryPolicy.
+type retryWriter struct {
+	next   outbound.WriterPort
+	policy RetryPolicy
+}
+
+func (rw *retryWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	attempts := rw.policy.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var result domerr.Result[model.Unit]
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 && rw.policy.Delay > 0 {
+			time.Sleep(rw.policy.Delay)
+		}
+		result = rw.next.Write(ctx, message)
+		if result.IsOk() {
+			return result
+		}
+	}
+	return result
+}
+
+// timingWriter reports each write's duration to the configured callback.
+type timingWriter struct {
+	next   outbound.WriterPort
+	report func(time.Duration)
+}
+
+func (tw *timingWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	start := time.Now()
+	result := tw.next.Write(ctx, message)
+	tw.report(time.Since(start))
+	return result
+}
+
+// staticPrefixWriter prepends a fixed prefix to every message.
+type staticPrefixWriter struct {
+	next   outbound.WriterPort
+	prefix string
+}
+
+func (pw *staticPrefixWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return pw.next.Write(ctx, pw.prefix+message)
+}
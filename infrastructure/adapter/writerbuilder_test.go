@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// flakyWriter fails the first failures writes, then succeeds, recording
+// every attempted message.
+type flakyWriter struct {
+	failures int
+	attempts []string
+}
+
+func (fw *flakyWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	fw.attempts = append(fw.attempts, message)
+	if len(fw.attempts) <= fw.failures {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError("transient failure"))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestInfrastructureAdapterWriterBuilder tests the fluent decorator builder.
+func TestInfrastructureAdapterWriterBuilder(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.WriterBuilder")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Bare builder returns the base writer behavior
+	// ========================================================================
+
+	plain := &recordingWriter{}
+	w1 := NewWriterBuilder(plain).Build()
+	r1 := w1.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Bare build - Write returns Ok", r1.IsOk())
+	tf.RunTest("Bare build - message unchanged",
+		len(plain.messages) == 1 && plain.messages[0] == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Prefix + retry on a flaky base delivers the prefixed message
+	// ========================================================================
+
+	flaky := &flakyWriter{failures: 2}
+	w2 := NewWriterBuilder(flaky).
+		WithRetry(RetryPolicy{MaxAttempts: 3}).
+		WithPrefix("[greeter] ").
+		Build()
+	r2 := w2.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Prefix+retry - eventually Ok", r2.IsOk())
+	tf.RunTest("Prefix+retry - three attempts made", len(flaky.attempts) == 3)
+	allPrefixed := len(flaky.attempts) == 3
+	for _, msg := range flaky.attempts {
+		allPrefixed = allPrefixed && msg == "[greeter] Hello, Bob!"
+	}
+	tf.RunTest("Prefix+retry - every attempt carries the prefix", allPrefixed)
+
+	// ========================================================================
+	// Test: Retry exhaustion returns the last error
+	// ========================================================================
+
+	hopeless := &flakyWriter{failures: 10}
+	w3 := NewWriterBuilder(hopeless).
+		WithRetry(RetryPolicy{MaxAttempts: 2}).
+		Build()
+	r3 := w3.Write(ctx, "Hello, Carol!")
+	tf.RunTest("Retry exhausted - error returned", r3.IsError())
+	tf.RunTest("Retry exhausted - attempts bounded", len(hopeless.attempts) == 2)
+
+	// ========================================================================
+	// Test: Timing callback observes each write, including retries
+	// ========================================================================
+
+	var durations []time.Duration
+	timed := &flakyWriter{failures: 1}
+	w4 := NewWriterBuilder(timed).
+		WithRetry(RetryPolicy{MaxAttempts: 2}).
+		WithTiming(func(d time.Duration) { durations = append(durations, d) }).
+		Build()
+	r4 := w4.Write(ctx, "Hello, Dave!")
+	tf.RunTest("Timing - Write returns Ok", r4.IsOk())
+	tf.RunTest("Timing - one measurement per Write call", len(durations) == 1)
+	tf.RunTest("Timing - duration is non-negative",
+		len(durations) == 1 && durations[0] >= 0)
+
+	// ========================================================================
+	// Test: Declaration order does not change the applied order
+	// ========================================================================
+
+	reordered := &flakyWriter{failures: 1}
+	w5 := NewWriterBuilder(reordered).
+		WithPrefix("[x] ").
+		WithRetry(RetryPolicy{MaxAttempts: 2}).
+		Build()
+	r5 := w5.Write(ctx, "Hi")
+	tf.RunTest("Fixed order - Ok after retry", r5.IsOk())
+	tf.RunTest("Fixed order - retries see prefixed message",
+		len(reordered.attempts) == 2 && reordered.attempts[1] == "[x] Hi")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
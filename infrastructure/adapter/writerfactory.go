@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Named writer factory registry for pluggable output selection
+
+package adapter
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// WriterFactory constructs a WriterPort from a string configuration map.
+// Factories validate their own configuration and report problems through
+// the Result rather than panicking.
+type WriterFactory func(cfg map[string]string) domerr.Result[outbound.WriterPort]
+
+var (
+	factoryMu sync.RWMutex
+	factories = make(map[string]WriterFactory)
+)
+
+// RegisterWriterFactory registers f under name, replacing any factory
+// previously registered under the same name. Safe for concurrent use.
+//
+// Adapters register themselves (or are registered by the composition root)
+// so the CLI can select an output by name without the bootstrap layer
+// hard-coding every concrete writer type.
+func RegisterWriterFactory(name string, f WriterFactory) {
+	factoryMu.Lock()
+	defer factoryMu.Unlock()
+	factories[name] = f
+}
+
+// NewWriterByName constructs a writer via the factory registered under name,
+// passing cfg through. An unregistered name is a ValidationError naming the
+// offender; configuration problems surface from the factory itself.
+//
+// Usage:
+//
+//	result := adapter.NewWriterByName("console", nil)
+func NewWriterByName(name string, cfg map[string]string) domerr.Result[outbound.WriterPort] {
+	factoryMu.RLock()
+	f, ok := factories[name]
+	factoryMu.RUnlock()
+
+	if !ok {
+		return domerr.Err[outbound.WriterPort](apperr.NewValidationError(
+			fmt.Sprintf("unknown writer %q", name)))
+	}
+	return f(cfg)
+}
+
+// The console writer is always available by name; it writes to the real
+// process stdout, matching what the greeter binary wires by default.
+func init() {
+	RegisterWriterFactory("console", func(cfg map[string]string) domerr.Result[outbound.WriterPort] {
+		return domerr.Ok[outbound.WriterPort](NewWriter(os.Stdout))
+	})
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"strings"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterWriterFactory tests the named writer registry.
+func TestInfrastructureAdapterWriterFactory(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.WriterFactory")
+
+	// ========================================================================
+	// Test: A registered factory constructs writers by name
+	// ========================================================================
+
+	var seenCfg map[string]string
+	RegisterWriterFactory("fake", func(cfg map[string]string) domerr.Result[outbound.WriterPort] {
+		seenCfg = cfg
+		return domerr.Ok[outbound.WriterPort](&recordingWriter{})
+	})
+
+	r1 := NewWriterByName("fake", map[string]string{"level": "debug"})
+	tf.RunTest("Registered name - construction succeeds", r1.IsOk())
+	tf.RunTest("Registered name - writer usable", r1.IsOk() && r1.Value() != nil)
+	tf.RunTest("Registered name - cfg passed through",
+		seenCfg != nil && seenCfg["level"] == "debug")
+
+	// ========================================================================
+	// Test: An unknown name fails with a ValidationError naming it
+	// ========================================================================
+
+	r2 := NewWriterByName("no-such-writer", nil)
+	tf.RunTest("Unknown name - error returned", r2.IsError())
+	tf.RunTest("Unknown name - kind is validation",
+		r2.IsError() && r2.ErrorInfo().Kind == apperr.ValidationError)
+	tf.RunTest("Unknown name - message names the writer",
+		r2.IsError() && strings.Contains(r2.ErrorInfo().Message, "no-such-writer"))
+
+	// ========================================================================
+	// Test: A factory's own failure propagates unchanged
+	// ========================================================================
+
+	RegisterWriterFactory("broken", func(cfg map[string]string) domerr.Result[outbound.WriterPort] {
+		return domerr.Err[outbound.WriterPort](apperr.NewInfrastructureError("cannot connect"))
+	})
+	r3 := NewWriterByName("broken", nil)
+	tf.RunTest("Factory failure - error propagates",
+		r3.IsError() && r3.ErrorInfo().Message == "cannot connect")
+
+	// ========================================================================
+	// Test: The console writer is registered out of the box
+	// ========================================================================
+
+	r4 := NewWriterByName("console", nil)
+	tf.RunTest("Built-in console - available by name", r4.IsOk())
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: config
+// Description: Greeting template loading from disk
+
+// Package config provides infrastructure helpers for loading configuration
+// artifacts (e.g. greeting templates) from disk at startup, converting I/O
+// and validation failures to Result errors.
+//
+// Architecture Notes:
+//   - Part of the INFRASTRUCTURE layer
+//   - Read failures map to InfrastructureError, malformed content to
+//     ValidationError, so callers can distinguish environment problems
+//     from bad configuration
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// templatePlaceholder is the substitution verb a greeting template must
+// contain exactly once (the position where the name is inserted).
+const templatePlaceholder = "%s"
+
+// LoadTemplateFile reads a greeting template from path and validates it.
+//
+// A valid template contains exactly one %s placeholder; surrounding
+// whitespace is trimmed before validation so trailing newlines from
+// editors do not affect the template.
+//
+// Errors:
+//   - InfrastructureError: the file could not be read
+//   - ValidationError: the template has zero or more than one %s
+//
+// Example:
+//
+//	result := config.LoadTemplateFile("/etc/greeter/template.txt")
+//	if result.IsOk() {
+//	    message := fmt.Sprintf(result.Value(), name)
+//	}
+func LoadTemplateFile(path string) domerr.Result[string] {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domerr.Err[string](apperr.NewInfrastructureError(
+			fmt.Sprintf("failed to read template file: %v", err)))
+	}
+
+	template := strings.TrimSpace(string(data))
+	if count := strings.Count(template, templatePlaceholder); count != 1 {
+		return domerr.Err[string](apperr.NewValidationError(
+			fmt.Sprintf("template must contain exactly one %s placeholder, found %d",
+				templatePlaceholder, count)))
+	}
+
+	return domerr.Ok(template)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// writeTemplate creates a template file in dir and returns its path.
+func writeTemplate(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write template fixture: %v", err)
+	}
+	return path
+}
+
+// TestInfrastructureConfigLoadTemplateFile tests template loading.
+func TestInfrastructureConfigLoadTemplateFile(t *testing.T) {
+	tf := test.New("Infrastructure.Config.LoadTemplateFile")
+	dir := t.TempDir()
+
+	// ========================================================================
+	// Test: Valid template loads with whitespace trimmed
+	// ========================================================================
+
+	valid := writeTemplate(t, dir, "valid.txt", "Greetings, %s!\n")
+	r1 := LoadTemplateFile(valid)
+	tf.RunTest("Valid template - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		tf.RunTest("Valid template - trimmed content returned",
+			r1.Value() == "Greetings, %s!")
+	}
+
+	// ========================================================================
+	// Test: Missing file is an infrastructure error
+	// ========================================================================
+
+	r2 := LoadTemplateFile(filepath.Join(dir, "missing.txt"))
+	tf.RunTest("Missing file - IsError returns true", r2.IsError())
+	if r2.IsError() {
+		tf.RunTest("Missing file - kind is infrastructure",
+			r2.ErrorInfo().Kind == domerr.InfrastructureError)
+	}
+
+	// ========================================================================
+	// Test: Zero placeholders is a validation error
+	// ========================================================================
+
+	zero := writeTemplate(t, dir, "zero.txt", "Hello, world!")
+	r3 := LoadTemplateFile(zero)
+	tf.RunTest("Zero placeholders - IsError returns true", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("Zero placeholders - kind is validation",
+			r3.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: Two placeholders is a validation error
+	// ========================================================================
+
+	two := writeTemplate(t, dir, "two.txt", "Hello, %s and %s!")
+	r4 := LoadTemplateFile(two)
+	tf.RunTest("Two placeholders - IsError returns true", r4.IsError())
+	if r4.IsError() {
+		tf.RunTest("Two placeholders - kind is validation",
+			r4.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: Error message formatting for stderr rendering
+
+package command
+
+import (
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+)
+
+// errorContinuationIndent prefixes every line after the first in a
+// multi-line error message so wrapped messages and cause chains line up
+// under the "Error: " heading instead of rendering ragged.
+const errorContinuationIndent = "  "
+
+// formatErrorMessage renders an ErrorType's message for stderr.
+//
+// Each line is trimmed of surrounding whitespace, blank lines are dropped,
+// and every line after the first is indented by errorContinuationIndent.
+// Single-line messages pass through trimmed and unindented.
+func formatErrorMessage(e apperr.ErrorType) string {
+	lines := strings.Split(e.Message, "\n")
+
+	formatted := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if len(formatted) > 0 {
+			line = errorContinuationIndent + line
+		}
+		formatted = append(formatted, line)
+	}
+	return strings.Join(formatted, "\n")
+}
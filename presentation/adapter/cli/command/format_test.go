@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command
+
+import (
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestPresentationCommandFormatErrorMessage tests stderr error formatting.
+func TestPresentationCommandFormatErrorMessage(t *testing.T) {
+	tf := test.New("Presentation.Command.FormatErrorMessage")
+
+	// ========================================================================
+	// Test: Single-line messages pass through trimmed
+	// ========================================================================
+
+	tf.RunTest("Single line - passes through",
+		formatErrorMessage(apperr.NewValidationError("name cannot be empty")) ==
+			"name cannot be empty")
+
+	tf.RunTest("Single line - surrounding whitespace trimmed",
+		formatErrorMessage(apperr.NewValidationError("  name cannot be empty  ")) ==
+			"name cannot be empty")
+
+	// ========================================================================
+	// Test: Multi-line messages get consistent continuation indent
+	// ========================================================================
+
+	multi := apperr.NewInfrastructureError("write failed\ncaused by: disk full")
+	tf.RunTest("Multi line - continuation indented",
+		formatErrorMessage(multi) == "write failed\n  caused by: disk full")
+
+	ragged := apperr.NewInfrastructureError("write failed\n   caused by: disk full\n\tcaused by: quota exceeded")
+	tf.RunTest("Multi line - ragged indentation normalized",
+		formatErrorMessage(ragged) ==
+			"write failed\n  caused by: disk full\n  caused by: quota exceeded")
+
+	// ========================================================================
+	// Test: Blank lines are dropped
+	// ========================================================================
+
+	blanks := apperr.NewInfrastructureError("write failed\n\n\ncaused by: disk full\n")
+	tf.RunTest("Blank lines - dropped from output",
+		formatErrorMessage(blanks) == "write failed\n  caused by: disk full")
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: CLI greet command (driving/primary adapter)
+
+// Package command provides CLI command adapters - driving/primary adapters
+// that translate command-line arguments into application use case calls and
+// use case Results into process exit codes.
+//
+// Architecture Notes:
+//   - Part of the PRESENTATION layer (driving/primary adapters)
+//   - Depends on Application layer ports only (never Infrastructure)
+//   - Wiring of concrete writers happens in the bootstrap composition root
+//   - Converts Result errors to exit codes and stderr diagnostics
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/presentation/adapter/cli/command"
+//
+//	cmd := command.NewGreetCommand(useCase, os.Stdout, os.Stderr)
+//	code := cmd.Run(ctx, os.Args[1:])
+//	os.Exit(code)
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	appcmd "github.com/abitofhelp/hybrid_lib_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/inbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Exit codes returned by Run.
+//
+// Contract:
+//   - ExitSuccess (0): greeting was written
+//   - ExitValidation (1): argument/usage error or domain validation failure
+//   - ExitInfrastructure (2): write failure or cancellation
+const (
+	ExitSuccess        = apperr.ExitCodeSuccess
+	ExitValidation     = apperr.ExitCodeValidation
+	ExitInfrastructure = apperr.ExitCodeInfrastructure
+)
+
+// shortUsage is printed to stderr on argument errors.
+const shortUsage = "Usage: greeter [options] <name> [<name>...]\n" +
+	"Options:\n" +
+	"  --strict            reject whitespace-only and untrimmed names\n" +
+	"  --names-from=LIST   greet a comma-separated list of names\n" +
+	"  --echo              dump each received name (hex and decoded) to stderr\n" +
+	"  --lang=CODE         greeting language (en, fr, es, de)\n" +
+	"  --upper, --uppercase  upper-case the greeting\n" +
+	"  --quiet             no output; validate names and exit\n"
+
+// GreetCommand is a CLI adapter that runs the greet use case.
+//
+// Design Pattern: Command (presentation-side)
+//   - Parses command-line arguments
+//   - Delegates to the application layer via the GreetPort input port
+//   - Maps the Result to an exit code, printing errors to stderr
+type GreetCommand struct {
+	useCase inbound.GreetPort
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+// NewGreetCommand creates a GreetCommand with injected dependencies.
+//
+// The stdout writer is used for informational output (e.g. help text); the
+// greeting itself flows through the use case's WriterPort, wired by the
+// composition root. The stderr writer receives usage and error diagnostics.
+func NewGreetCommand(useCase inbound.GreetPort, stdout, stderr io.Writer) *GreetCommand {
+	return &GreetCommand{
+		useCase: useCase,
+		stdout:  stdout,
+		stderr:  stderr,
+	}
+}
+
+// Run parses args (excluding the program name), executes the greet use case,
+// and returns the process exit code.
+//
+// Behavior:
+//   - At least one positional argument (a name) is required
+//   - Several names run as a batch: every name is attempted, successful
+//     greetings go to stdout, and a per-kind summary line goes to stderr
+//   - --strict rejects whitespace-only and untrimmed names before the
+//     domain's lenient validation runs (backward-compatible default: off)
+//   - --echo dumps each received name (hex plus decoded string) to stderr
+//     before greeting, for diagnosing shell encoding surprises
+//   - --quiet suppresses all output and only validates, so CI scripts can
+//     rely on the exit code alone
+//   - Validation failures exit with ExitValidation, write failures with
+//     ExitInfrastructure
+func (c *GreetCommand) Run(ctx context.Context, args []string) int {
+	parsed, parseResult := parseArgs(args)
+	if parseResult.IsError() {
+		// Quiet mode suppresses even usage diagnostics; scan the raw args
+		// because a failed parse may not have reached the flag.
+		if !hasQuietFlag(args) {
+			fmt.Fprintf(c.stderr, "Error: %s\n", parseResult.ErrorInfo().Message)
+			fmt.Fprint(c.stderr, shortUsage)
+		}
+		return ExitValidation
+	}
+
+	// Quiet mode: validate every name through the use case without writing
+	// anything anywhere - CI scripts read only the exit code.
+	if parsed.quiet {
+		return c.runQuiet(ctx, parsed)
+	}
+
+	// Echo diagnostics: show exactly what the shell handed us - hex bytes
+	// alongside the decoded string - so smart quotes and invisible
+	// characters are visible before any greeting or validation output.
+	if parsed.echo {
+		for _, name := range parsed.names {
+			fmt.Fprintf(c.stderr, "echo: %q [% x]\n", name, []byte(name))
+		}
+	}
+
+	// Batch mode: attempt every name and summarize the outcomes. An inline
+	// list always uses the batch path so its summary shape is stable.
+	if len(parsed.names) > 1 || parsed.forceBatch {
+		return c.runBatch(ctx, parsed)
+	}
+	name := parsed.names[0]
+
+	// Strict pre-validation: the domain deliberately preserves whitespace
+	// (a whitespace-only name is valid and greets verbatim), so the stricter
+	// policy is opt-in at the presentation boundary.
+	if parsed.strict {
+		if msg, bad := strictNameViolation(name); bad {
+			fmt.Fprintf(c.stderr, "Error: %s\n", msg)
+			return ExitValidation
+		}
+	}
+
+	// Collapse the Result into an exit code with the terminal Fold
+	// combinator; the error branch renders diagnostics as a side effect.
+	return domerr.Fold(c.useCase.Execute(ctx, greetCommandFor(name, parsed)),
+		func(model.Unit) int { return ExitSuccess },
+		func(info apperr.ErrorType) int {
+			fmt.Fprintf(c.stderr, "Error: %s\n", formatErrorMessage(info))
+			return apperr.ExitCodeFor(info)
+		})
+}
+
+// greetCommandFor builds the use case DTO for one name, carrying the
+// per-invocation greeting options (--lang, --upper) alongside it.
+func greetCommandFor(name string, parsed parsedArgs) appcmd.GreetCommand {
+	return appcmd.GreetCommand{
+		Name:      name,
+		Language:  parsed.lang,
+		Uppercase: parsed.upper,
+	}
+}
+
+// runBatch greets every name, letting failures skip ahead rather than
+// aborting the batch, then prints a per-kind outcome summary to stderr.
+//
+// Exit code reflects the worst outcome observed: any infrastructure error
+// dominates, then any validation error, else success.
+func (c *GreetCommand) runBatch(ctx context.Context, parsed parsedArgs) int {
+	results := make([]domerr.Result[model.Unit], 0, len(parsed.names))
+	for _, name := range parsed.names {
+		if parsed.strict {
+			if msg, bad := strictNameViolation(name); bad {
+				results = append(results, domerr.Err[model.Unit](apperr.NewValidationError(msg)))
+				continue
+			}
+		}
+		results = append(results, c.useCase.Execute(ctx, greetCommandFor(name, parsed)))
+	}
+
+	values, byKind := domerr.PartitionByKind(results)
+
+	// Every non-success kind is reported: the two common kinds get their
+	// own counts, anything else (timeouts, future kinds) lands in the
+	// catch-all so no failure can vanish from the summary.
+	other := 0
+	for kind, errs := range byKind {
+		if kind != apperr.ValidationError && kind != apperr.InfrastructureError {
+			other += len(errs)
+		}
+	}
+	fmt.Fprintf(c.stderr, "succeeded: %d, validation errors: %d, infrastructure errors: %d, other errors: %d\n",
+		len(values),
+		len(byKind[apperr.ValidationError]),
+		len(byKind[apperr.InfrastructureError]),
+		other)
+
+	// Fold the central kind-to-exit-code mapping over every observed kind
+	// (worst wins), so a new ErrorKind changes this path in one place —
+	// ExitCodeFor — exactly like the single-name and quiet paths.
+	worst := ExitSuccess
+	for _, errs := range byKind {
+		if len(errs) > 0 {
+			worst = max(worst, apperr.ExitCodeFor(errs[0]))
+		}
+	}
+	return worst
+}
+
+// greetValidator is the optional write-free validation capability of a
+// greet port (implemented by GreetUseCase.Validate). Quiet mode prefers it
+// so no greeting is generated or written; asserted as an optional
+// interface to keep inbound.GreetPort itself minimal.
+type greetValidator interface {
+	Validate(ctx context.Context, cmd appcmd.GreetCommand) domerr.Result[model.Unit]
+}
+
+// runQuiet validates every name without producing any output, returning
+// the worst exit code observed (0 valid, 1 invalid).
+func (c *GreetCommand) runQuiet(ctx context.Context, parsed parsedArgs) int {
+	worst := ExitSuccess
+	for _, name := range parsed.names {
+		if parsed.strict {
+			if _, bad := strictNameViolation(name); bad {
+				worst = max(worst, ExitValidation)
+				continue
+			}
+		}
+		if result := c.validate(ctx, greetCommandFor(name, parsed)); result.IsError() {
+			worst = max(worst, apperr.ExitCodeFor(result.ErrorInfo()))
+		}
+	}
+	return worst
+}
+
+// validate runs the write-free validation when the port offers one,
+// falling back to a full Execute otherwise (decorated ports may not
+// forward Validate; the fallback keeps quiet mode correct, if noisier
+// toward the writer).
+func (c *GreetCommand) validate(ctx context.Context, cmd appcmd.GreetCommand) domerr.Result[model.Unit] {
+	if v, ok := c.useCase.(greetValidator); ok {
+		return v.Validate(ctx, cmd)
+	}
+	return c.useCase.Execute(ctx, cmd)
+}
+
+// hasQuietFlag reports whether the raw argument list requests quiet mode,
+// for the parse-error path where parsing never completed.
+func hasQuietFlag(args []string) bool {
+	for _, arg := range args {
+		if arg == "--quiet" || arg == "-quiet" {
+			return true
+		}
+	}
+	return false
+}
+
+// strictNameViolation reports whether name violates the strict policy:
+// whitespace-only names and names with leading/trailing whitespace are
+// rejected. Returns a human-readable reason when bad is true.
+func strictNameViolation(name string) (msg string, bad bool) {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return "name must not be whitespace-only in strict mode", true
+	}
+	if trimmed != name {
+		return "name must not have leading or trailing whitespace in strict mode", true
+	}
+	return "", false
+}
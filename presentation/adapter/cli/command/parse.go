@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: Argument parsing for the greet command
+
+package command
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// parsedArgs is the structured outcome of parsing greet arguments: the
+// recognized flags plus the accumulated names to greet.
+type parsedArgs struct {
+	strict bool
+	names  []string
+	// forceBatch routes even a single name through the batch path so that
+	// an inline --names-from list has a stable summary shape.
+	forceBatch bool
+	// echo dumps each received name (hex plus decoded string) to stderr
+	// before greeting, for diagnosing shell encoding surprises.
+	echo bool
+	// lang selects the greeting language; the zero value means English.
+	lang valueobject.Language
+	// upper requests the greeting be upper-cased before writing.
+	upper bool
+	// quiet suppresses all output, relying on the exit code alone.
+	quiet bool
+}
+
+// parseArgs parses args (excluding the program name) into parsedArgs,
+// independent of any I/O so parsing is table-testable on its own.
+//
+// Parsing is built on a stdlib flag.FlagSet rather than a hand-rolled
+// switch, so new options declare themselves in one line. The FlagSet is
+// re-applied after each positional, preserving the historical behavior
+// that flags and names may interleave in any order.
+//
+// The Result is Ok when parsing succeeded and a ValidationError otherwise;
+// the error message is suitable for an "Error: <message>" diagnostic line.
+// Parse outcomes that fail:
+//   - a flag missing its required value
+//   - an unknown flag
+//   - no names at all (neither positional nor from an inline list)
+func parseArgs(args []string) (parsedArgs, domerr.Result[model.Unit]) {
+	var parsed parsedArgs
+
+	fs := flag.NewFlagSet("greeter", flag.ContinueOnError)
+	fs.SetOutput(io.Discard) // errors are rendered by the caller, not the FlagSet
+	strict := fs.Bool("strict", false, "reject whitespace-only and untrimmed names")
+	echo := fs.Bool("echo", false, "dump each received name (hex and decoded) to stderr")
+	namesFrom := fs.String("names-from", "", "greet a comma-separated list of names")
+	lang := fs.String("lang", "", "greeting language (en, fr, es, de)")
+	upper := fs.Bool("upper", false, "upper-case the greeting")
+	fs.BoolVar(upper, "uppercase", false, "alias for --upper")
+	quiet := fs.Bool("quiet", false, "no output; validate names and exit")
+
+	// Interleaving loop: Parse stops at the first positional, so collect it
+	// as a name and resume parsing the remainder until args are exhausted.
+	rest := args
+	for {
+		if err := fs.Parse(rest); err != nil {
+			return parsed, domerr.Err[model.Unit](domerr.NewValidationError(
+				flagErrorMessage(err)))
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		parsed.names = append(parsed.names, fs.Arg(0))
+		rest = fs.Args()[1:]
+	}
+
+	parsed.strict = *strict
+	parsed.echo = *echo
+	parsed.lang = valueobject.Language(*lang)
+	parsed.upper = *upper
+	parsed.quiet = *quiet
+
+	// An inline list contributes names exactly like positionals; entries
+	// that are empty after trimming flow through as validation errors.
+	// Visit distinguishes an explicitly empty list from an absent flag.
+	haveNamesFrom := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "names-from" {
+			haveNamesFrom = true
+		}
+	})
+	if haveNamesFrom {
+		parsed.forceBatch = true
+		for _, part := range strings.Split(*namesFrom, ",") {
+			parsed.names = append(parsed.names, strings.TrimSpace(part))
+		}
+	}
+
+	if len(parsed.names) == 0 {
+		return parsed, domerr.Err[model.Unit](domerr.NewValidationError(
+			"a name is required"))
+	}
+	return parsed, domerr.Ok(model.UnitValue)
+}
+
+// flagErrorMessage rewrites stdlib FlagSet errors into this CLI's
+// diagnostic style, which quotes flags in their double-dash form.
+func flagErrorMessage(err error) string {
+	msg := err.Error()
+	if name, ok := strings.CutPrefix(msg, "flag needs an argument: -"); ok {
+		return "--" + strings.TrimPrefix(name, "-") + " requires a value"
+	}
+	if name, ok := strings.CutPrefix(msg, "flag provided but not defined: -"); ok {
+		return fmt.Sprintf("unknown flag %q", "--"+strings.TrimPrefix(name, "-"))
+	}
+	return msg
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestPresentationCommandParseArgs tests argument parsing in isolation.
+func TestPresentationCommandParseArgs(t *testing.T) {
+	tf := test.New("Presentation.Command.ParseArgs")
+
+	// ========================================================================
+	// Test: A single positional name parses cleanly
+	// ========================================================================
+
+	p1, r1 := parseArgs([]string{"Alice"})
+	tf.RunTest("Single name - Ok", r1.IsOk())
+	tf.RunTest("Single name - captured",
+		len(p1.names) == 1 && p1.names[0] == "Alice" && !p1.strict && !p1.forceBatch)
+
+	// ========================================================================
+	// Test: Flags combine with positionals in any order
+	// ========================================================================
+
+	p2, r2 := parseArgs([]string{"--strict", "Bob"})
+	tf.RunTest("Strict flag - Ok and recorded", r2.IsOk() && p2.strict)
+
+	p3, r3 := parseArgs([]string{"Carol", "--strict"})
+	tf.RunTest("Trailing flag - Ok and recorded", r3.IsOk() && p3.strict)
+
+	// ========================================================================
+	// Test: Inline lists merge after positionals and force batch mode
+	// ========================================================================
+
+	p4, r4 := parseArgs([]string{"Dave", "--names-from=Eve, Frank"})
+	tf.RunTest("Names-from inline - Ok", r4.IsOk())
+	tf.RunTest("Names-from inline - merged and trimmed",
+		len(p4.names) == 3 && p4.names[1] == "Eve" && p4.names[2] == "Frank")
+	tf.RunTest("Names-from inline - forces batch", p4.forceBatch)
+
+	p5, r5 := parseArgs([]string{"--names-from", "Grace"})
+	tf.RunTest("Names-from token form - Ok", r5.IsOk() && len(p5.names) == 1)
+
+	// ========================================================================
+	// Test: Missing name fails with a validation message
+	// ========================================================================
+
+	_, r6 := parseArgs([]string{})
+	tf.RunTest("No args - error", r6.IsError())
+	tf.RunTest("No args - message names the problem",
+		r6.IsError() && strings.Contains(r6.ErrorInfo().Message, "name"))
+
+	_, r7 := parseArgs([]string{"--strict"})
+	tf.RunTest("Flag only - error", r7.IsError())
+
+	// ========================================================================
+	// Test: Flag misuse fails with a validation message
+	// ========================================================================
+
+	_, r8 := parseArgs([]string{"--names-from"})
+	tf.RunTest("Names-from without value - error",
+		r8.IsError() && strings.Contains(r8.ErrorInfo().Message, "--names-from"))
+
+	_, r9 := parseArgs([]string{"--bogus", "Alice"})
+	tf.RunTest("Unknown flag - error",
+		r9.IsError() && strings.Contains(r9.ErrorInfo().Message, "--bogus"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
+
+// TestPresentationCommandParseGreetingOptions tests the FlagSet-backed
+// greeting options in varied positions.
+func TestPresentationCommandParseGreetingOptions(t *testing.T) {
+	tf := test.New("Presentation.Command.ParseGreetingOptions")
+
+	// ========================================================================
+	// Test: Options before the name
+	// ========================================================================
+
+	p1, r1 := parseArgs([]string{"--lang=fr", "--upper", "Alice"})
+	tf.RunTest("Leading flags - Ok", r1.IsOk())
+	tf.RunTest("Leading flags - language recorded", p1.lang == valueobject.French)
+	tf.RunTest("Leading flags - upper recorded", p1.upper)
+	tf.RunTest("Leading flags - name captured",
+		len(p1.names) == 1 && p1.names[0] == "Alice")
+
+	// ========================================================================
+	// Test: Options after and around the name
+	// ========================================================================
+
+	p2, r2 := parseArgs([]string{"Bob", "--lang", "es"})
+	tf.RunTest("Trailing flag - Ok", r2.IsOk())
+	tf.RunTest("Trailing flag - language recorded", p2.lang == valueobject.Spanish)
+
+	p3, r3 := parseArgs([]string{"--upper", "Carol", "--lang=de"})
+	tf.RunTest("Straddling flags - Ok", r3.IsOk())
+	tf.RunTest("Straddling flags - both recorded",
+		p3.upper && p3.lang == valueobject.German)
+
+	// ========================================================================
+	// Test: No flags keeps the defaults
+	// ========================================================================
+
+	p4, r4 := parseArgs([]string{"Dave"})
+	tf.RunTest("No flags - Ok", r4.IsOk())
+	tf.RunTest("No flags - zero-value options",
+		p4.lang == "" && !p4.upper && !p4.strict && !p4.echo)
+
+	// ========================================================================
+	// Test: Flag misuse keeps the CLI's double-dash diagnostics
+	// ========================================================================
+
+	_, r5 := parseArgs([]string{"--lang"})
+	tf.RunTest("Lang without value - error mentions the flag",
+		r5.IsError() && strings.Contains(r5.ErrorInfo().Message, "--lang"))
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
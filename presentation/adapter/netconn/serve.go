@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: netconn
+// Description: Line-protocol network greeter (driving/primary adapter)
+
+// Package netconn provides a network presentation adapter speaking a simple
+// line protocol: each line received on a connection is a name, and the
+// greeting (or an error line) is written back on the same connection.
+//
+// Architecture Notes:
+//   - Part of the PRESENTATION layer (driving/primary adapters)
+//   - Depends on Application layer ports only (never Infrastructure)
+//   - The composition root wires the use case's writer over the same
+//     connection, so greetings flow back to the peer
+package netconn
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+
+	appcmd "github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/inbound"
+)
+
+// ServeConn serves the greet line protocol on conn until the peer closes
+// the connection or ctx is cancelled.
+//
+// Protocol:
+//   - Each received line is treated as one name
+//   - Successful greetings are written by the use case's writer, which the
+//     caller wires over this same connection
+//   - Failures produce an "Error: <message>" line on the connection, and
+//     serving continues with the next name
+//
+// Example:
+//
+//	writer := newConnWriter(conn) // WriterPort over the connection
+//	uc := usecase.NewGreetUseCase[*connWriter](writer)
+//	netconn.ServeConn(ctx, conn, uc)
+func ServeConn(ctx context.Context, conn net.Conn, uc inbound.GreetPort) {
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		name := scanner.Text()
+		result := uc.Execute(ctx, appcmd.NewGreetCommand(name))
+		if result.IsError() {
+			fmt.Fprintf(conn, "Error: %s\n", result.ErrorInfo().Message)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package netconn
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// connWriter is a WriterPort over a net.Conn, writing one message per line.
+// The presentation layer cannot import infrastructure, so tests wire this
+// local double the way a composition root would wire a real conn writer.
+type connWriter struct {
+	conn net.Conn
+}
+
+func (cw *connWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if _, err := cw.conn.Write([]byte(message + "\n")); err != nil {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(err.Error()))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestPresentationNetconnServeConn tests the line-protocol greeter.
+func TestPresentationNetconnServeConn(t *testing.T) {
+	tf := test.New("Presentation.Netconn.ServeConn")
+
+	server, client := net.Pipe()
+	uc := usecase.NewGreetUseCase[*connWriter](&connWriter{conn: server})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer server.Close()
+		ServeConn(context.Background(), server, uc)
+	}()
+
+	reader := bufio.NewReader(client)
+	send := func(line string) string {
+		if _, err := client.Write([]byte(line + "\n")); err != nil {
+			t.Fatalf("client write failed: %v", err)
+		}
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("client read failed: %v", err)
+		}
+		return strings.TrimSuffix(reply, "\n")
+	}
+
+	// ========================================================================
+	// Test: Valid names are greeted back over the same connection
+	// ========================================================================
+
+	tf.RunTest("Valid name - greeting returned", send("Alice") == "Hello, Alice!")
+	tf.RunTest("Second name - connection stays open", send("Bob") == "Hello, Bob!")
+
+	// ========================================================================
+	// Test: An invalid name produces an error line, serving continues
+	// ========================================================================
+
+	errLine := send("")
+	tf.RunTest("Invalid name - error line returned",
+		strings.HasPrefix(errLine, "Error: ") && strings.Contains(errLine, "empty"))
+	tf.RunTest("After error - next name still greeted", send("Carol") == "Hello, Carol!")
+
+	// ========================================================================
+	// Test: Closing the client ends the serve loop
+	// ========================================================================
+
+	client.Close()
+	<-done
+	tf.RunTest("Close - serve loop returned", true)
+
+	// Print summary and fail test if any failures
+	tf.Summary(t)
+}
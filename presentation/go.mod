@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+module github.com/abitofhelp/hybrid_lib_go/presentation
+
+go 1.23
+
+// Presentation layer - Driving/primary adapters (CLI commands)
+// Depends on application + domain layers
+
+require github.com/abitofhelp/hybrid_lib_go/application v0.0.0
+
+require github.com/abitofhelp/hybrid_lib_go/domain v0.0.0
+
+replace (
+	github.com/abitofhelp/hybrid_lib_go/application => ../application
+	github.com/abitofhelp/hybrid_lib_go/domain => ../domain
+)